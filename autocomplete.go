@@ -1,11 +1,26 @@
 package clime
 
 import (
+	"context"
 	"fmt"
 	"golang.org/x/term"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// TabBehavior sets what Tab does in an autocomplete prompt
+type TabBehavior int
+
+const (
+	// TabComplete fills in the selected suggestion and closes the list
+	TabComplete TabBehavior = iota
+	// TabCycle fills in the next suggestion on each press, like bash, keeping the
+	// list open until Enter is pressed or the input is edited
+	TabCycle
 )
 
 type AutoCompleteConfig struct {
@@ -19,12 +34,20 @@ type AutoCompleteConfig struct {
 	Required      bool
 	Validate      func(string) error
 	Transform     func(string) string
+	// OptionsFunc, when set, supplies candidates for the current input on each
+	// keystroke instead of filtering Options
+	OptionsFunc func(input string) []string
+	// DebounceInterval delays calling OptionsFunc until typing pauses for this long
+	DebounceInterval time.Duration
+	TabBehavior      TabBehavior
 }
 
 type AutoCompleteResult struct {
 	Value string
 	Score int
 	Index int
+	// Matches holds the rune positions in Value that matched the input
+	Matches []int
 }
 
 // AutoComplete prompts for input with autocomplete functionality
@@ -36,10 +59,7 @@ func AutoComplete(config AutoCompleteConfig) (string, error) {
 		config.MinLength = 0
 	}
 
-	prompt := buildAutoCompletePrompt(config)
-	fmt.Print(prompt)
-
-	input, err := readLineWithAutoComplete(config)
+	input, err := readLineWithAutoComplete(config, buildAutoCompletePrompt(config))
 	if err != nil {
 		return "", err
 	}
@@ -68,113 +88,122 @@ func AutoComplete(config AutoCompleteConfig) (string, error) {
 }
 
 // readLineWithAutoComplete reads input with autocomplete functionality
-func readLineWithAutoComplete(config AutoCompleteConfig) (string, error) {
+func readLineWithAutoComplete(config AutoCompleteConfig, prompt string) (string, error) {
 	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		fmt.Print(prompt)
 		return readLine()
 	}
 
 	oldState, err := term.MakeRaw(int(os.Stdin.Fd()))
 	if err != nil {
+		fmt.Print(prompt)
 		return readLine()
 	}
 	defer term.Restore(int(os.Stdin.Fd()), oldState)
 
-	var input strings.Builder
+	editor := newLineEditor(prompt)
 	var suggestions []AutoCompleteResult
 	selectedSuggestion := 0
-	showingSuggestions := false
 
-	redrawLine := func() {
-		if showingSuggestions {
-			clearAutoCompleteSuggestions(len(suggestions))
-			showingSuggestions = false
+	refresh := func() {
+		suggestions = findSuggestions(editor.String(), config)
+		if len(editor.buffer) < config.MinLength {
+			suggestions = nil
 		}
-		
-		suggestions = findSuggestions(input.String(), config)
-		if len(suggestions) > 0 && input.Len() >= config.MinLength {
-			if selectedSuggestion >= len(suggestions) {
-				selectedSuggestion = 0
-			}
-			showSuggestions(suggestions, selectedSuggestion, input.String())
-			showingSuggestions = true
+		if selectedSuggestion >= len(suggestions) {
+			selectedSuggestion = 0
 		}
+		showSuggestions(editor, suggestions, selectedSuggestion)
 	}
 
+	debounce := config.OptionsFunc != nil && config.DebounceInterval > 0
+	fetchPending := false
+	// cycling is set while Tab is stepping through the suggestions with TabCycle
+	cycling := false
+
+	editor.render()
+
 	for {
-		b := make([]byte, 4)
-		n, err := os.Stdin.Read(b)
+		ctx, cancel := context.Background(), context.CancelFunc(func() {})
+		if fetchPending {
+			ctx, cancel = context.WithTimeout(ctx, config.DebounceInterval)
+		}
+		key, err := readKey(ctx)
+		cancel()
+		if err == context.DeadlineExceeded {
+			fetchPending = false
+			refresh()
+			continue
+		}
 		if err != nil {
 			return "", err
 		}
 
-		if n == 1 {
-			switch b[0] {
-			case 13:
-				if showingSuggestions {
-					clearAutoCompleteSuggestions(len(suggestions))
-				}
-				fmt.Println()
-				return input.String(), nil
-
-			case 127, 8:
-				if input.Len() > 0 {
-					inputStr := input.String()
-					input.Reset()
-					input.WriteString(inputStr[:len(inputStr)-1])
-					
-					fmt.Print("\b \b")
-					selectedSuggestion = 0
-					redrawLine()
-				}
+		switch {
+		case key.Type == KeyEnter:
+			editor.finish()
+			return editor.String(), nil
+
+		case key.Type == KeyCtrlC:
+			editor.finish()
+			return "", fmt.Errorf("input cancelled")
 
-			case 9:
-				if showingSuggestions && len(suggestions) > 0 {
-					clearAutoCompleteSuggestions(len(suggestions))
-					showingSuggestions = false
-					
-					backspaces := input.Len()
-					input.Reset()
-					input.WriteString(suggestions[selectedSuggestion].Value)
-					
-					for i := 0; i < backspaces; i++ {
-						fmt.Print("\b")
-					}
-					fmt.Print(input.String())
+		case key.Type == KeyTab && config.TabBehavior == TabCycle:
+			if len(suggestions) > 0 {
+				if cycling {
+					selectedSuggestion = (selectedSuggestion + 1) % len(suggestions)
 				}
+				cycling = true
+				fetchPending = false
+				editor.set(suggestions[selectedSuggestion].Value)
+				showSuggestions(editor, suggestions, selectedSuggestion)
+			}
 
-			case 27:
-				continue
+		case key.Type == KeyTab:
+			if len(suggestions) > 0 {
+				editor.set(suggestions[selectedSuggestion].Value)
+				suggestions = nil
+				clearAutoCompleteSuggestions(editor)
+			}
 
-			default:
-				if b[0] >= 32 && b[0] <= 126 {
-					input.WriteByte(b[0])
-					fmt.Printf("%c", b[0])
-					selectedSuggestion = 0
-					redrawLine()
+		case editor.ghost != "" && editor.cursor == len(editor.buffer) && (key.Type == KeyRight || key.Type == KeyEnd):
+			editor.set(editor.String() + editor.ghost)
+			fetchPending = false
+			refresh()
+
+		case key.Type == KeyUp || key.Type == KeyDown:
+			if len(suggestions) > 0 {
+				if key.Type == KeyUp {
+					selectedSuggestion = (selectedSuggestion - 1 + len(suggestions)) % len(suggestions)
+				} else {
+					selectedSuggestion = (selectedSuggestion + 1) % len(suggestions)
 				}
-			}
-		} else if n >= 3 && b[0] == 27 && b[1] == 91 {
-			switch b[2] {
-			case 65:
-				if showingSuggestions && len(suggestions) > 0 {
-					if selectedSuggestion > 0 {
-						selectedSuggestion--
-					} else {
-						selectedSuggestion = len(suggestions) - 1
-					}
-					clearAutoCompleteSuggestions(len(suggestions))
-					showSuggestions(suggestions, selectedSuggestion, input.String())
+				if cycling {
+					editor.set(suggestions[selectedSuggestion].Value)
 				}
-			case 66:
-				if showingSuggestions && len(suggestions) > 0 {
-					if selectedSuggestion < len(suggestions)-1 {
-						selectedSuggestion++
-					} else {
-						selectedSuggestion = 0
-					}
-					clearAutoCompleteSuggestions(len(suggestions))
-					showSuggestions(suggestions, selectedSuggestion, input.String())
+				showSuggestions(editor, suggestions, selectedSuggestion)
+			}
+
+		default:
+			before := editor.String()
+			if !editor.handleKey(key) {
+				continue
+			}
+			if editor.String() == before {
+				if !cycling {
+					refresh()
 				}
+				continue
+			}
+
+			cycling = false
+			selectedSuggestion = 0
+			if debounce {
+				// Keep the previous suggestions until typing pauses
+				fetchPending = true
+				showSuggestions(editor, suggestions, selectedSuggestion)
+			} else {
+				refresh()
 			}
 		}
 	}
@@ -182,36 +211,84 @@ func readLineWithAutoComplete(config AutoCompleteConfig) (string, error) {
 
 // findSuggestions finds matching suggestions for the given input
 func findSuggestions(input string, config AutoCompleteConfig) []AutoCompleteResult {
-	if len(input) < config.MinLength || len(config.Options) == 0 {
+	if len(input) < config.MinLength {
+		return nil
+	}
+
+	if config.OptionsFunc != nil {
+		return dynamicSuggestions(input, config.OptionsFunc(input), config)
+	}
+
+	if len(config.Options) == 0 {
 		return nil
 	}
 
 	var results []AutoCompleteResult
 
 	for i, option := range config.Options {
-		score := calculateMatchScore(input, option, config)
+		score, matches := calculateMatchScore(input, option, config)
 		if score > 0 {
 			results = append(results, AutoCompleteResult{
-				Value: option,
-				Score: score,
-				Index: i,
+				Value:   option,
+				Score:   score,
+				Index:   i,
+				Matches: matches,
 			})
 		}
 	}
 
-	sort.Slice(results, func(i, j int) bool {
+	sort.SliceStable(results, func(i, j int) bool {
 		return results[i].Score > results[j].Score
 	})
 
-	if len(results) > config.MaxResults {
+	if config.MaxResults > 0 && len(results) > config.MaxResults {
+		results = results[:config.MaxResults]
+	}
+
+	return results
+}
+
+// dynamicSuggestions turns candidates from OptionsFunc into results, keeping the
+// order they were supplied in and marking where they match the input
+func dynamicSuggestions(input string, candidates []string, config AutoCompleteConfig) []AutoCompleteResult {
+	results := make([]AutoCompleteResult, 0, len(candidates))
+	for i, candidate := range candidates {
+		score, matches := calculateMatchScore(input, candidate, config)
+		results = append(results, AutoCompleteResult{
+			Value:   candidate,
+			Score:   score,
+			Index:   i,
+			Matches: matches,
+		})
+	}
+
+	if config.MaxResults > 0 && len(results) > config.MaxResults {
 		results = results[:config.MaxResults]
 	}
 
 	return results
 }
 
-// calculateMatchScore calculates how well an option matches the input
-func calculateMatchScore(input, option string, config AutoCompleteConfig) int {
+// RankSuggestions scores options against input the way AutoComplete does with config
+// and returns the matches, best first. OptionsFunc is ignored and a MaxResults of 0
+// returns every match
+func RankSuggestions(input string, options []string, config AutoCompleteConfig) []AutoCompleteResult {
+	config.Options = options
+	config.OptionsFunc = nil
+	return findSuggestions(input, config)
+}
+
+// FuzzyScore returns how well candidate matches input when the characters of input
+// appear in candidate in order, ignoring case. Consecutive matches score higher and
+// gaps between matched characters lower; 0 means candidate does not match
+func FuzzyScore(input, candidate string) int {
+	score, _ := fuzzyMatchScore(strings.ToLower(input), strings.ToLower(candidate))
+	return score
+}
+
+// calculateMatchScore calculates how well an option matches the input and which
+// rune positions of the option matched
+func calculateMatchScore(input, option string, config AutoCompleteConfig) (int, []int) {
 	if !config.CaseSensitive {
 		input = strings.ToLower(input)
 		option = strings.ToLower(option)
@@ -222,77 +299,146 @@ func calculateMatchScore(input, option string, config AutoCompleteConfig) int {
 	}
 
 	if strings.HasPrefix(option, input) {
-		return 1000 - len(option) + len(input)*10
+		return 1000 - len(option) + len(input)*10, matchRange(0, utf8.RuneCountInString(input))
 	}
 
 	if strings.Contains(option, input) {
 		index := strings.Index(option, input)
-		return 500 - index + len(input)*5
+		start := utf8.RuneCountInString(option[:index])
+		return 500 - index + len(input)*5, matchRange(start, utf8.RuneCountInString(input))
 	}
 
-	return 0
+	return 0, nil
+}
+
+// matchRange returns the positions start, start+1, ... for count runes
+func matchRange(start, count int) []int {
+	positions := make([]int, count)
+	for i := range positions {
+		positions[i] = start + i
+	}
+	return positions
 }
 
-// fuzzyMatchScore calculates fuzzy match score
-func fuzzyMatchScore(input, option string) int {
+// fuzzyMatchScore calculates fuzzy match score and the rune positions of the matched
+// characters. The score is 0 unless every rune of input is found in option in order
+func fuzzyMatchScore(input, option string) (int, []int) {
 	if len(input) == 0 {
-		return 0
+		return 0, nil
 	}
 
+	inputRunes := []rune(input)
 	score := 0
 	inputIndex := 0
 	consecutiveMatches := 0
+	lastMatch := -1
+	var positions []int
 
+	position := 0
 	for _, char := range option {
-		if inputIndex < len(input) && char == rune(input[inputIndex]) {
+		if inputIndex < len(inputRunes) && char == inputRunes[inputIndex] {
 			score += 10 + consecutiveMatches
+			if lastMatch >= 0 {
+				// Penalise characters skipped between two matches
+				score -= 2 * (position - lastMatch - 1)
+			}
 			consecutiveMatches++
 			inputIndex++
+			lastMatch = position
+			positions = append(positions, position)
 		} else {
 			consecutiveMatches = 0
 		}
+		position++
 	}
 
-	if inputIndex == len(input) {
-		score += 100
+	if inputIndex < len(inputRunes) {
+		return 0, nil
 	}
 
-	score -= len(option) - len(input)
+	score += 100
+	score -= position - len(inputRunes)
 
-	return score
+	return max(score, 1), positions
 }
 
-// displayAutoCompleteSuggestions displays autocomplete suggestions
-func showSuggestions(suggestions []AutoCompleteResult, selected int, currentInput string) {
-	fmt.Print("\n")
-	
+// highlightMatches colors the runes of value at the matched positions with highlight
+// and the rest with base, grouping runs to keep escape codes short
+func highlightMatches(value string, matches []int, base, highlight *Color) string {
+	matched := make(map[int]bool, len(matches))
+	for _, position := range matches {
+		matched[position] = true
+	}
+
+	var result strings.Builder
+	var run strings.Builder
+	runMatched := false
+
+	flush := func() {
+		if run.Len() == 0 {
+			return
+		}
+		color := base
+		if runMatched {
+			color = highlight
+		}
+		result.WriteString(color.Sprint(run.String()))
+		run.Reset()
+	}
+
+	position := 0
+	for _, char := range value {
+		if matched[position] != runMatched {
+			flush()
+			runMatched = matched[position]
+		}
+		run.WriteRune(char)
+		position++
+	}
+	flush()
+
+	return result.String()
+}
+
+// showSuggestions redraws the input with the suggestion list below it
+func showSuggestions(editor *lineEditor, suggestions []AutoCompleteResult, selected int) {
+	editor.below = suggestionLines(suggestions, selected)
+	editor.ghost = ""
+	if len(suggestions) > 0 {
+		editor.ghost = ghostSuffix(editor.String(), suggestions[selected].Value)
+	}
+	editor.render()
+}
+
+// suggestionLines renders the suggestion list, marking the selected one with an arrow
+func suggestionLines(suggestions []AutoCompleteResult, selected int) []string {
+	lines := make([]string, 0, len(suggestions))
 	for i, suggestion := range suggestions {
 		if i == selected {
-			fmt.Printf("  %s %s\n", Success.Sprint("→"), BoldColor.Sprint(suggestion.Value))
+			value := highlightMatches(suggestion.Value, suggestion.Matches, BoldColor, Info)
+			lines = append(lines, fmt.Sprintf("  %s %s", Success.Sprint("→"), value))
 		} else {
-			fmt.Printf("    %s\n", DimColor.Sprint(suggestion.Value))
+			value := highlightMatches(suggestion.Value, suggestion.Matches, DimColor, Info)
+			lines = append(lines, fmt.Sprintf("    %s", value))
 		}
 	}
-	
-	fmt.Printf("\033[%dA", len(suggestions)+1)
-	fmt.Print("\033[999C")
+	return lines
 }
 
-// clearAutoCompleteSuggestions clears autocomplete suggestions
-func clearAutoCompleteSuggestions(lines int) {
-	if lines <= 0 {
-		return
+// ghostSuffix returns the part of suggestion that would complete input, or "" when
+// the suggestion does not start with the input
+func ghostSuffix(input, suggestion string) string {
+	if input == "" || len(suggestion) <= len(input) || !strings.EqualFold(suggestion[:len(input)], input) {
+		return ""
 	}
-	
-	fmt.Print("\n")
-	for i := 0; i < lines; i++ {
-		fmt.Print("\033[2K")
-		if i < lines-1 {
-			fmt.Print("\033[B")
-		}
-	}
-	fmt.Printf("\033[%dA", lines+1)
-	fmt.Print("\033[999C")
+	return suggestion[len(input):]
+}
+
+// clearAutoCompleteSuggestions redraws the input without suggestions
+func clearAutoCompleteSuggestions(editor *lineEditor) {
+	editor.below = nil
+	editor.ghost = ""
+	editor.render()
 }
 
 // buildAutoCompletePrompt builds the autocomplete prompt
@@ -323,23 +469,79 @@ func AskWithOptions(label string, options []string) (string, error) {
 	})
 }
 
-// AskWithFileCompletion prompts for a file path with file completion
+// AskWithFileCompletion prompts for a file path, completing entries of the
+// directory being typed. A leading "~" is expanded in the returned path.
 func AskWithFileCompletion(label string) (string, error) {
-	files, err := os.ReadDir(".")
+	return AutoComplete(AutoCompleteConfig{
+		Label:         label,
+		OptionsFunc:   fileCompletionOptions,
+		MaxResults:    10,
+		CaseSensitive: true,
+		Transform:     expandHomePath,
+	})
+}
+
+// fileCompletionOptions lists the entries of the directory named by input up to
+// its last "/" whose names start with the trailing component. Suggestions keep
+// the typed directory prefix and directories end with "/".
+func fileCompletionOptions(input string) []string {
+	if input == "~" {
+		return []string{"~/"}
+	}
+
+	dir, prefix := "", input
+	if i := strings.LastIndex(input, "/"); i >= 0 {
+		dir, prefix = input[:i+1], input[i+1:]
+	}
+
+	readDir := expandHomePath(dir)
+	if readDir == "" {
+		readDir = "."
+	}
+
+	entries, err := os.ReadDir(readDir)
 	if err != nil {
-		return Ask(label)
+		return nil
 	}
 
 	var options []string
-	for _, file := range files {
-		options = append(options, file.Name())
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		// Hidden entries only show up once a "." has been typed
+		if strings.HasPrefix(name, ".") && !strings.HasPrefix(prefix, ".") {
+			continue
+		}
+
+		isDir := entry.IsDir()
+		if entry.Type()&os.ModeSymlink != 0 {
+			if info, err := os.Stat(filepath.Join(readDir, name)); err == nil {
+				isDir = info.IsDir()
+			}
+		}
+		if isDir {
+			name += "/"
+		}
+		options = append(options, dir+name)
 	}
 
-	return AutoComplete(AutoCompleteConfig{
-		Label:      label,
-		Options:    options,
-		FuzzyMatch: true,
-	})
+	return options
+}
+
+// expandHomePath replaces a leading "~" or "~/" in path with the user's home directory
+func expandHomePath(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return home + path[1:]
 }
 
 // AskWithCommandCompletion prompts with common command completion
@@ -427,6 +629,12 @@ func (b *AutoCompleteBuilder) WithTransformer(transformer func(string) string) *
 	return b
 }
 
+// WithTabBehavior sets whether Tab completes the selected suggestion or cycles through them
+func (b *AutoCompleteBuilder) WithTabBehavior(behavior TabBehavior) *AutoCompleteBuilder {
+	b.config.TabBehavior = behavior
+	return b
+}
+
 // Ask executes the autocomplete prompt
 func (b *AutoCompleteBuilder) Ask() (string, error) {
 	return AutoComplete(b.config)
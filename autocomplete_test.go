@@ -0,0 +1,32 @@
+package clime
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSuggestionLinesMarksSelected(t *testing.T) {
+	suggestions := []AutoCompleteResult{
+		{Value: "apple", Matches: []int{0, 1}},
+		{Value: "apricot", Matches: []int{0, 1}},
+	}
+
+	lines := suggestionLines(suggestions, 1)
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2", len(lines))
+	}
+
+	if strings.Contains(lines[0], "→") {
+		t.Errorf("unselected line %q has the arrow", lines[0])
+	}
+	if plain := removeANSIEscapeCodes(lines[1]); plain != "  → apricot" {
+		t.Errorf("selected line = %q, want %q", plain, "  → apricot")
+	}
+}
+
+func TestHighlightMatchesKeepsText(t *testing.T) {
+	got := highlightMatches("apricot", []int{0, 2, 3}, DimColor, Info)
+	if plain := removeANSIEscapeCodes(got); plain != "apricot" {
+		t.Errorf("highlightMatches text = %q, want %q", plain, "apricot")
+	}
+}
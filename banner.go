@@ -16,53 +16,26 @@ type BannerStyle struct {
 }
 
 var (
-	BannerStyleDefault = BannerStyle{
-		TopLeft:     "┌",
-		TopRight:    "┐",
-		BottomLeft:  "└",
-		BottomRight: "┘",
-		Horizontal:  "─",
-		Vertical:    "│",
-		Padding:     1,
-	}
-	BannerStyleRounded = BannerStyle{
-		TopLeft:     "╭",
-		TopRight:    "╮",
-		BottomLeft:  "╰",
-		BottomRight: "╯",
-		Horizontal:  "─",
-		Vertical:    "│",
-		Padding:     1,
-	}
-	BannerStyleBold = BannerStyle{
-		TopLeft:     "┏",
-		TopRight:    "┓",
-		BottomLeft:  "┗",
-		BottomRight: "┛",
-		Horizontal:  "━",
-		Vertical:    "┃",
-		Padding:     1,
-	}
-	BannerStyleDouble = BannerStyle{
-		TopLeft:     "╔",
-		TopRight:    "╗",
-		BottomLeft:  "╚",
-		BottomRight: "╝",
-		Horizontal:  "═",
-		Vertical:    "║",
-		Padding:     1,
-	}
-	BannerStyleSimple = BannerStyle{
-		TopLeft:     "+",
-		TopRight:    "+",
-		BottomLeft:  "+",
-		BottomRight: "+",
-		Horizontal:  "-",
-		Vertical:    "|",
-		Padding:     1,
-	}
+	BannerStyleDefault = NewBannerStyle(BorderStyleDefault, 1)
+	BannerStyleRounded = NewBannerStyle(BorderStyleRounded, 1)
+	BannerStyleBold    = NewBannerStyle(BorderStyleBold, 1)
+	BannerStyleDouble  = NewBannerStyle(BorderStyleDouble, 1)
+	BannerStyleSimple  = NewBannerStyle(BorderStyleSimple, 1)
 )
 
+// NewBannerStyle creates a banner style from the corners and edges of a shared border style
+func NewBannerStyle(border BorderStyle, padding int) BannerStyle {
+	return BannerStyle{
+		TopLeft:     border.TopLeft,
+		TopRight:    border.TopRight,
+		BottomLeft:  border.BottomLeft,
+		BottomRight: border.BottomRight,
+		Horizontal:  border.Horizontal,
+		Vertical:    border.Vertical,
+		Padding:     padding,
+	}
+}
+
 type BannerType int
 
 const (
@@ -74,14 +47,21 @@ const (
 
 type Banner struct {
 	message          string
+	lines            []string
 	bannerType       BannerType
 	style            BannerStyle
 	color            *Color
 	borderColor      *Color
+	gradientStart    *Color
+	gradientEnd      *Color
 	width            int
 	multiline        bool
+	fitMessage       bool
+	ellipsis         string
+	icon             string
 	ResponsiveConfig *ResponsiveConfig
 	useSmartSizing   bool
+	smartRatio       float64
 }
 
 // NewBanner creates a new banner
@@ -92,7 +72,9 @@ func NewBanner(message string, bannerType BannerType) *Banner {
 		style:          BannerStyleDefault,
 		width:          SmartWidth(0.9), // Use 90% of smart width
 		multiline:      true,
+		ellipsis:       "...",
 		useSmartSizing: true,
+		smartRatio:     0.9,
 	}
 
 	switch bannerType {
@@ -119,6 +101,12 @@ func (b *Banner) WithStyle(style BannerStyle) *Banner {
 	return b
 }
 
+// WithBorderStyle draws the banner with a shared border style, keeping the current padding
+func (b *Banner) WithBorderStyle(style BorderStyle) *Banner {
+	b.style = NewBannerStyle(style, b.style.Padding)
+	return b
+}
+
 // WithColor sets the text color
 func (b *Banner) WithColor(color *Color) *Banner {
 	b.color = color
@@ -131,7 +119,17 @@ func (b *Banner) WithBorderColor(color *Color) *Banner {
 	return b
 }
 
-// WithWidth sets the banner width
+// WithBorderGradient colors the top and bottom borders with a gradient from start
+// to end across the banner width, overriding the border color. The left and right
+// borders take the start and end colors
+func (b *Banner) WithBorderGradient(start, end *Color) *Banner {
+	b.gradientStart = start
+	b.gradientEnd = end
+	return b
+}
+
+// WithWidth sets the banner width. Single-line banners keep this width and
+// truncate longer messages instead of widening
 func (b *Banner) WithWidth(width int) *Banner {
 	if width > 0 {
 		b.width = width
@@ -143,6 +141,7 @@ func (b *Banner) WithWidth(width int) *Banner {
 // WithSmartWidth enables smart responsive width sizing
 func (b *Banner) WithSmartWidth(percentage float64) *Banner {
 	b.width = SmartWidth(percentage)
+	b.smartRatio = percentage
 	b.useSmartSizing = true
 	return b
 }
@@ -160,9 +159,42 @@ func (b *Banner) Multiline(enable bool) *Banner {
 	return b
 }
 
+// FitMessage controls whether a single-line banner is exactly as wide as its
+// message, narrower than the default width for short messages. The width is
+// capped at the terminal width, beyond which the message is truncated
+func (b *Banner) FitMessage(enable bool) *Banner {
+	b.fitMessage = enable
+	return b
+}
+
+// WithIcon sets an icon shown before the first line of the message
+func (b *Banner) WithIcon(icon string) *Banner {
+	b.icon = icon
+	return b
+}
+
+// WithEllipsis sets the marker appended to truncated messages
+func (b *Banner) WithEllipsis(ellipsis string) *Banner {
+	b.ellipsis = ellipsis
+	return b
+}
+
+// AddLine adds a line shown below the message as given, without re-flowing it,
+// for bullet lists and explicit line breaks
+func (b *Banner) AddLine(line string) *Banner {
+	b.lines = append(b.lines, line)
+	return b
+}
+
+// AddLines adds multiple lines shown below the message as given
+func (b *Banner) AddLines(lines ...string) *Banner {
+	b.lines = append(b.lines, lines...)
+	return b
+}
+
 // Render renders the banner and returns the string representation
 func (b *Banner) Render() string {
-	if b.message == "" {
+	if b.message == "" && len(b.lines) == 0 {
 		return ""
 	}
 
@@ -180,8 +212,8 @@ func (b *Banner) Render() string {
 	result.WriteString("\n")
 
 	lines := b.prepareLines()
-	for _, line := range lines {
-		result.WriteString(b.renderContentLine(line))
+	for i, line := range lines {
+		result.WriteString(b.renderContentLine(line, i == 0))
 		result.WriteString("\n")
 	}
 
@@ -192,22 +224,28 @@ func (b *Banner) Render() string {
 
 // Print renders and prints the banner
 func (b *Banner) Print() {
-	fmt.Print(b.Render())
+	fmt.Fprint(outputWriter(), b.Render())
 }
 
 // Println renders and prints the banner with a newline
 func (b *Banner) Println() {
-	fmt.Println(b.Render())
+	fmt.Fprintln(outputWriter(), b.Render())
 }
 
-// prepareLines prepares the message lines for rendering
+// FitsTerminal reports whether the rendered banner fits the terminal width
+// and by how many columns it overflows when it does not
+func (b *Banner) FitsTerminal() (bool, int) {
+	return measureOverflow(b.Render())
+}
+
+// prepareLines prepares the message lines for rendering, followed by the lines
+// added with AddLine
 func (b *Banner) prepareLines() []string {
 	if b.message == "" {
-		return []string{}
+		return append([]string{}, b.lines...)
 	}
 
-	// Calculate available width for content
-	availableWidth := b.width - (2 * b.style.Padding) - 2 // 2 for borders
+	availableWidth := b.width - b.frameWidth()
 
 	if availableWidth <= 0 {
 		availableWidth = 10
@@ -216,33 +254,15 @@ func (b *Banner) prepareLines() []string {
 	var lines []string
 
 	if b.multiline {
-		words := strings.Fields(b.message)
-		var currentLine strings.Builder
-
-		for _, word := range words {
-			if currentLine.Len() == 0 {
-				currentLine.WriteString(word)
-			} else if getVisualWidth(currentLine.String())+1+getVisualWidth(word) <= availableWidth {
-				currentLine.WriteString(" " + word)
-			} else {
-				lines = append(lines, currentLine.String())
-				currentLine.Reset()
-				currentLine.WriteString(word)
-			}
-		}
-
-		if currentLine.Len() > 0 {
-			lines = append(lines, currentLine.String())
+		lines = WrapTextHard(b.message, availableWidth)
+		if len(lines) == 0 {
+			lines = []string{""}
 		}
 	} else {
-		if getVisualWidth(b.message) > availableWidth {
-			lines = append(lines, TruncateString(b.message, availableWidth))
-		} else {
-			lines = append(lines, b.message)
-		}
+		lines = append(lines, truncateWithEllipsis(b.message, availableWidth, b.ellipsis))
 	}
 
-	return lines
+	return append(lines, b.lines...)
 }
 
 // calculateResponsiveSize calculates responsive banner size
@@ -262,7 +282,7 @@ func (b *Banner) calculateResponsiveSize() {
 	}
 
 	if b.useSmartSizing {
-		b.width = SmartWidth(0.9)
+		b.width = SmartWidth(b.smartRatio)
 	}
 }
 
@@ -270,69 +290,130 @@ func (b *Banner) calculateResponsiveSize() {
 func (b *Banner) renderTopBorder() string {
 	borderWidth := b.width - 2
 	border := b.style.TopLeft + strings.Repeat(b.style.Horizontal, borderWidth) + b.style.TopRight
-
-	if b.borderColor != nil {
-		return b.borderColor.Sprint(border)
-	}
-	return border
+	return b.colorBorder(border)
 }
 
 // renderBottomBorder renders the bottom border
 func (b *Banner) renderBottomBorder() string {
 	borderWidth := b.width - 2
 	border := b.style.BottomLeft + strings.Repeat(b.style.Horizontal, borderWidth) + b.style.BottomRight
+	return b.colorBorder(border)
+}
+
+// hasBorderGradient reports whether a border gradient has been set
+func (b *Banner) hasBorderGradient() bool {
+	return b.gradientStart != nil && b.gradientEnd != nil
+}
+
+// colorBorder colors a horizontal border line, interpolating each character across
+// the border gradient when one is set
+func (b *Banner) colorBorder(border string) string {
+	if !b.hasBorderGradient() {
+		if b.borderColor != nil {
+			return b.borderColor.Sprint(border)
+		}
+		return border
+	}
+
+	runes := []rune(border)
+	span := float64(len(runes) - 1)
+	if span < 1 {
+		span = 1
+	}
+
+	var result strings.Builder
+	for i, char := range runes {
+		result.WriteString(LerpColor(b.gradientStart, b.gradientEnd, float64(i)/span).Sprint(string(char)))
+	}
+	return result.String()
+}
+
+// colorVertical colors a side border, using the gradient's start or end color when set
+func (b *Banner) colorVertical(left bool) string {
+	color := b.borderColor
+	if b.hasBorderGradient() {
+		color = b.gradientEnd
+		if left {
+			color = b.gradientStart
+		}
+	}
 
-	if b.borderColor != nil {
-		return b.borderColor.Sprint(border)
+	if color != nil {
+		return color.Sprint(b.style.Vertical)
 	}
-	return border
+	return b.style.Vertical
 }
 
-// calculateOptimalWidth calculates the optimal banner width
+// calculateOptimalWidth settles the banner width before the lines are prepared.
+// Multiline banners and banners with added lines grow to their widest line. Single-line banners widen
+// to the message unless a width was set with WithWidth, in which case the message
+// is truncated to it; FitMessage makes them exactly as wide as the message. The
+// result is capped at the terminal width
 func (b *Banner) calculateOptimalWidth() {
-	lines := b.prepareLines()
-	maxLineLength := b.getMaxLineLength(lines)
+	messageWidth := getVisualWidth(b.message) + b.frameWidth()
+
+	switch {
+	case b.multiline || len(b.lines) > 0:
+		b.width = max(b.width, b.getMaxLineLength(b.prepareLines())+b.frameWidth())
+	case b.fitMessage:
+		b.width = messageWidth
+	case b.useSmartSizing:
+		b.width = max(b.width, messageWidth)
+	}
 
-	requiredWidth := maxLineLength + (2 * b.style.Padding) + 2
+	b.width = min(b.width, GetTerminal().Width())
+}
 
-	if requiredWidth > b.width {
-		b.width = requiredWidth
+// frameWidth returns the width taken by the borders, padding and icon around the message
+func (b *Banner) frameWidth() int {
+	return 2*b.style.Padding + 2 + b.iconWidth()
+}
+
+// iconWidth returns the visual width taken by the icon and its trailing space
+func (b *Banner) iconWidth() int {
+	if b.icon == "" {
+		return 0
 	}
+	return getVisualWidth(b.icon) + 1
 }
 
-// renderContentLine renders a single line of content with padding and border
-func (b *Banner) renderContentLine(line string) string {
+// renderContentLine renders a single line of content with padding and border.
+// The icon is shown on the first line; following lines are indented to align with it
+func (b *Banner) renderContentLine(line string, first bool) string {
 	availableWidth := b.width - 2
 
+	if contentWidth := availableWidth - (2 * b.style.Padding) - b.iconWidth(); getVisualWidth(line) > contentWidth {
+		line = truncateWithEllipsis(line, contentWidth, b.ellipsis)
+	}
+
 	var content strings.Builder
 
-	if b.borderColor != nil {
-		content.WriteString(b.borderColor.Sprint(b.style.Vertical))
-	} else {
-		content.WriteString(b.style.Vertical)
-	}
+	content.WriteString(b.colorVertical(true))
 
 	content.WriteString(strings.Repeat(" ", b.style.Padding))
 
+	if b.icon != "" {
+		if first {
+			content.WriteString(b.icon + " ")
+		} else {
+			content.WriteString(strings.Repeat(" ", b.iconWidth()))
+		}
+	}
+
 	if b.color != nil {
 		content.WriteString(b.color.Sprint(line))
 	} else {
 		content.WriteString(line)
 	}
 
-	usedWidth := (2 * b.style.Padding) + getVisualWidth(line)
+	usedWidth := (2 * b.style.Padding) + b.iconWidth() + getVisualWidth(line)
 	remainingSpace := availableWidth - usedWidth
 	if remainingSpace > 0 {
 		content.WriteString(strings.Repeat(" ", remainingSpace))
 	}
 
 	content.WriteString(strings.Repeat(" ", b.style.Padding))
-
-	if b.borderColor != nil {
-		content.WriteString(b.borderColor.Sprint(b.style.Vertical))
-	} else {
-		content.WriteString(b.style.Vertical)
-	}
+	content.WriteString(b.colorVertical(false))
 
 	return content.String()
 }
@@ -353,14 +434,14 @@ func SuccessBanner(message string) {
 	NewBanner(message, BannerSuccess).Println()
 }
 
-// WarningBanner creates and displays a warning banner
+// WarningBanner creates and displays a warning banner on the error output
 func WarningBanner(message string) {
-	NewBanner(message, BannerWarning).Println()
+	fmt.Fprintln(diagnosticWriter(), NewBanner(message, BannerWarning).Render())
 }
 
-// ErrorBanner creates and displays an error banner
+// ErrorBanner creates and displays an error banner on the error output
 func ErrorBanner(message string) {
-	NewBanner(message, BannerError).Println()
+	fmt.Fprintln(diagnosticWriter(), NewBanner(message, BannerError).Render())
 }
 
 // InfoBanner creates and displays an info banner
@@ -370,22 +451,22 @@ func InfoBanner(message string) {
 
 // SuccessLine prints a simple success message with icon
 func SuccessLine(message string) {
-	fmt.Println(Success.Sprint("✓ " + message))
+	fmt.Fprintln(outputWriter(), Success.Sprint("✓ "+message))
 }
 
-// WarningLine prints a simple warning message with icon
+// WarningLine prints a simple warning message with icon to the error output
 func WarningLine(message string) {
-	fmt.Println(Warning.Sprint("⚠ " + message))
+	fmt.Fprintln(diagnosticWriter(), Warning.Sprint("⚠ "+message))
 }
 
-// ErrorLine prints a simple error message with icon
+// ErrorLine prints a simple error message with icon to the error output
 func ErrorLine(message string) {
-	fmt.Println(Error.Sprint("✗ " + message))
+	fmt.Fprintln(diagnosticWriter(), Error.Sprint("✗ "+message))
 }
 
 // InfoLine prints a simple info message with icon
 func InfoLine(message string) {
-	fmt.Println(Info.Sprint("ℹ " + message))
+	fmt.Fprintln(outputWriter(), Info.Sprint("ℹ "+message))
 }
 
 // CustomBanner creates a custom banner with specific colors and style
@@ -396,8 +477,9 @@ func CustomBanner(message string, textColor, borderColor *Color, style BannerSty
 		style:       style,
 		color:       textColor,
 		borderColor: borderColor,
-		width:       NewTerminal().Width() - 4,
+		width:       GetTerminal().Width() - 4,
 		multiline:   true,
+		ellipsis:    "...",
 	}
 
 	return banner
@@ -405,7 +487,7 @@ func CustomBanner(message string, textColor, borderColor *Color, style BannerSty
 
 // Header creates a header-style banner
 func Header(title string) {
-	terminal := NewTerminal()
+	terminal := GetTerminal()
 	width := terminal.Width()
 	if width > 80 {
 		width = 80
@@ -424,17 +506,18 @@ func Header(title string) {
 	}
 	titleLine += "="
 
-	fmt.Println(BoldColor.Sprint(header))
-	fmt.Println(BoldColor.Sprint(titleLine))
-	fmt.Println(BoldColor.Sprint(header))
+	out := outputWriter()
+	fmt.Fprintln(out, BoldColor.Sprint(header))
+	fmt.Fprintln(out, BoldColor.Sprint(titleLine))
+	fmt.Fprintln(out, BoldColor.Sprint(header))
 }
 
 // Separator prints a simple separator line
 func Separator() {
-	terminal := NewTerminal()
+	terminal := GetTerminal()
 	width := terminal.Width()
 	if width > 80 {
 		width = 80
 	}
-	fmt.Println(DimColor.Sprint(strings.Repeat("─", width)))
+	fmt.Fprintln(outputWriter(), Muted.Sprint(strings.Repeat("─", width)))
 }
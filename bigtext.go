@@ -0,0 +1,86 @@
+package clime
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// bigTextHeight is the number of rows in each BigText glyph
+const bigTextHeight = 5
+
+// bigTextFont maps supported characters to their block glyphs, one string per row
+var bigTextFont = map[rune][bigTextHeight]string{
+	'A': {" ███ ", "█   █", "█████", "█   █", "█   █"},
+	'B': {"████ ", "█   █", "████ ", "█   █", "████ "},
+	'C': {" ████", "█    ", "█    ", "█    ", " ████"},
+	'D': {"████ ", "█   █", "█   █", "█   █", "████ "},
+	'E': {"█████", "█    ", "████ ", "█    ", "█████"},
+	'F': {"█████", "█    ", "████ ", "█    ", "█    "},
+	'G': {" ████", "█    ", "█  ██", "█   █", " ████"},
+	'H': {"█   █", "█   █", "█████", "█   █", "█   █"},
+	'I': {"█████", "  █  ", "  █  ", "  █  ", "█████"},
+	'J': {"█████", "   █ ", "   █ ", "█  █ ", " ██  "},
+	'K': {"█   █", "█  █ ", "███  ", "█  █ ", "█   █"},
+	'L': {"█    ", "█    ", "█    ", "█    ", "█████"},
+	'M': {"█   █", "██ ██", "█ █ █", "█   █", "█   █"},
+	'N': {"█   █", "██  █", "█ █ █", "█  ██", "█   █"},
+	'O': {" ███ ", "█   █", "█   █", "█   █", " ███ "},
+	'P': {"████ ", "█   █", "████ ", "█    ", "█    "},
+	'Q': {" ███ ", "█   █", "█ █ █", "█  █ ", " ██ █"},
+	'R': {"████ ", "█   █", "████ ", "█  █ ", "█   █"},
+	'S': {" ████", "█    ", " ███ ", "    █", "████ "},
+	'T': {"█████", "  █  ", "  █  ", "  █  ", "  █  "},
+	'U': {"█   █", "█   █", "█   █", "█   █", " ███ "},
+	'V': {"█   █", "█   █", "█   █", " █ █ ", "  █  "},
+	'W': {"█   █", "█   █", "█ █ █", "██ ██", "█   █"},
+	'X': {"█   █", " █ █ ", "  █  ", " █ █ ", "█   █"},
+	'Y': {"█   █", " █ █ ", "  █  ", "  █  ", "  █  "},
+	'Z': {"█████", "   █ ", "  █  ", " █   ", "█████"},
+	'0': {" ███ ", "█  ██", "█ █ █", "██  █", " ███ "},
+	'1': {"  █  ", " ██  ", "  █  ", "  █  ", " ███ "},
+	'2': {" ███ ", "█   █", "  ██ ", " █   ", "█████"},
+	'3': {"████ ", "    █", " ███ ", "    █", "████ "},
+	'4': {"█   █", "█   █", "█████", "    █", "    █"},
+	'5': {"█████", "█    ", "████ ", "    █", "████ "},
+	'6': {" ███ ", "█    ", "████ ", "█   █", " ███ "},
+	'7': {"█████", "    █", "   █ ", "  █  ", "  █  "},
+	'8': {" ███ ", "█   █", " ███ ", "█   █", " ███ "},
+	'9': {" ███ ", "█   █", " ████", "    █", " ███ "},
+	' ': {"   ", "   ", "   ", "   ", "   "},
+}
+
+// BigText renders text as multi-line block letters. Letters, digits and spaces are
+// supported; text containing any other character is returned unchanged
+func BigText(text string) string {
+	var glyphs [][bigTextHeight]string
+	for _, char := range text {
+		glyph, ok := bigTextFont[unicode.ToUpper(char)]
+		if !ok {
+			return text
+		}
+		glyphs = append(glyphs, glyph)
+	}
+
+	if len(glyphs) == 0 {
+		return ""
+	}
+
+	rows := make([]string, bigTextHeight)
+	for row := range rows {
+		parts := make([]string, len(glyphs))
+		for i, glyph := range glyphs {
+			parts[i] = glyph[row]
+		}
+		rows[row] = strings.TrimRight(strings.Join(parts, " "), " ")
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// PrintBigText prints text as block letters in bold, like Header
+func PrintBigText(text string) {
+	for _, line := range strings.Split(BigText(text), "\n") {
+		fmt.Fprintln(outputWriter(), BoldColor.Sprint(line))
+	}
+}
@@ -0,0 +1,98 @@
+package clime
+
+// BorderStyle is the set of characters used to draw borders. Boxes and tables use
+// it directly (BoxStyle, TableStyle and TreeStyle are aliases) and banners via WithBorderStyle
+type BorderStyle struct {
+	TopLeft     string
+	TopRight    string
+	BottomLeft  string
+	BottomRight string
+	Horizontal  string
+	Vertical    string
+	Cross       string
+	TopTee      string
+	BottomTee   string
+	LeftTee     string
+	RightTee    string
+}
+
+var (
+	BorderStyleDefault = BorderStyle{
+		TopLeft:     "┌",
+		TopRight:    "┐",
+		BottomLeft:  "└",
+		BottomRight: "┘",
+		Horizontal:  "─",
+		Vertical:    "│",
+		Cross:       "┼",
+		TopTee:      "┬",
+		BottomTee:   "┴",
+		LeftTee:     "├",
+		RightTee:    "┤",
+	}
+	BorderStyleRounded = BorderStyle{
+		TopLeft:     "╭",
+		TopRight:    "╮",
+		BottomLeft:  "╰",
+		BottomRight: "╯",
+		Horizontal:  "─",
+		Vertical:    "│",
+		Cross:       "┼",
+		TopTee:      "┬",
+		BottomTee:   "┴",
+		LeftTee:     "├",
+		RightTee:    "┤",
+	}
+	BorderStyleBold = BorderStyle{
+		TopLeft:     "┏",
+		TopRight:    "┓",
+		BottomLeft:  "┗",
+		BottomRight: "┛",
+		Horizontal:  "━",
+		Vertical:    "┃",
+		Cross:       "╋",
+		TopTee:      "┳",
+		BottomTee:   "┻",
+		LeftTee:     "┣",
+		RightTee:    "┫",
+	}
+	BorderStyleDouble = BorderStyle{
+		TopLeft:     "╔",
+		TopRight:    "╗",
+		BottomLeft:  "╚",
+		BottomRight: "╝",
+		Horizontal:  "═",
+		Vertical:    "║",
+		Cross:       "╬",
+		TopTee:      "╦",
+		BottomTee:   "╩",
+		LeftTee:     "╠",
+		RightTee:    "╣",
+	}
+	BorderStyleSimple = BorderStyle{
+		TopLeft:     "+",
+		TopRight:    "+",
+		BottomLeft:  "+",
+		BottomRight: "+",
+		Horizontal:  "-",
+		Vertical:    "|",
+		Cross:       "+",
+		TopTee:      "+",
+		BottomTee:   "+",
+		LeftTee:     "+",
+		RightTee:    "+",
+	}
+	BorderStyleMinimal = BorderStyle{
+		TopLeft:     " ",
+		TopRight:    " ",
+		BottomLeft:  " ",
+		BottomRight: " ",
+		Horizontal:  " ",
+		Vertical:    " ",
+		Cross:       " ",
+		TopTee:      " ",
+		BottomTee:   " ",
+		LeftTee:     " ",
+		RightTee:    " ",
+	}
+)
@@ -5,99 +5,16 @@ import (
 	"strings"
 )
 
-type BoxStyle struct {
-	TopLeft     string
-	TopRight    string
-	BottomLeft  string
-	BottomRight string
-	Horizontal  string
-	Vertical    string
-	Cross       string
-	TopTee      string
-	BottomTee   string
-	LeftTee     string
-	RightTee    string
-}
+// BoxStyle is the border style of a box
+type BoxStyle = BorderStyle
 
 var (
-	BoxStyleDefault = BoxStyle{
-		TopLeft:     "┌",
-		TopRight:    "┐",
-		BottomLeft:  "└",
-		BottomRight: "┘",
-		Horizontal:  "─",
-		Vertical:    "│",
-		Cross:       "┼",
-		TopTee:      "┬",
-		BottomTee:   "┴",
-		LeftTee:     "├",
-		RightTee:    "┤",
-	}
-	BoxStyleRounded = BoxStyle{
-		TopLeft:     "╭",
-		TopRight:    "╮",
-		BottomLeft:  "╰",
-		BottomRight: "╯",
-		Horizontal:  "─",
-		Vertical:    "│",
-		Cross:       "┼",
-		TopTee:      "┬",
-		BottomTee:   "┴",
-		LeftTee:     "├",
-		RightTee:    "┤",
-	}
-	BoxStyleBold = BoxStyle{
-		TopLeft:     "┏",
-		TopRight:    "┓",
-		BottomLeft:  "┗",
-		BottomRight: "┛",
-		Horizontal:  "━",
-		Vertical:    "┃",
-		Cross:       "╋",
-		TopTee:      "┳",
-		BottomTee:   "┻",
-		LeftTee:     "┣",
-		RightTee:    "┫",
-	}
-	BoxStyleDouble = BoxStyle{
-		TopLeft:     "╔",
-		TopRight:    "╗",
-		BottomLeft:  "╚",
-		BottomRight: "╝",
-		Horizontal:  "═",
-		Vertical:    "║",
-		Cross:       "╬",
-		TopTee:      "╦",
-		BottomTee:   "╩",
-		LeftTee:     "╠",
-		RightTee:    "╣",
-	}
-	BoxStyleSimple = BoxStyle{
-		TopLeft:     "+",
-		TopRight:    "+",
-		BottomLeft:  "+",
-		BottomRight: "+",
-		Horizontal:  "-",
-		Vertical:    "|",
-		Cross:       "+",
-		TopTee:      "+",
-		BottomTee:   "+",
-		LeftTee:     "+",
-		RightTee:    "+",
-	}
-	BoxStyleMinimal = BoxStyle{
-		TopLeft:     " ",
-		TopRight:    " ",
-		BottomLeft:  " ",
-		BottomRight: " ",
-		Horizontal:  " ",
-		Vertical:    " ",
-		Cross:       " ",
-		TopTee:      " ",
-		BottomTee:   " ",
-		LeftTee:     " ",
-		RightTee:    " ",
-	}
+	BoxStyleDefault = BorderStyleDefault
+	BoxStyleRounded = BorderStyleRounded
+	BoxStyleBold    = BorderStyleBold
+	BoxStyleDouble  = BorderStyleDouble
+	BoxStyleSimple  = BorderStyleSimple
+	BoxStyleMinimal = BorderStyleMinimal
 )
 
 type BoxAlignment int
@@ -113,7 +30,9 @@ type Box struct {
 	title            string
 	style            BoxStyle
 	alignment        BoxAlignment
-	padding          int
+	paddingX         int
+	paddingY         int
+	customPadding    bool
 	width            int
 	height           int
 	color            *Color
@@ -123,6 +42,9 @@ type Box struct {
 	showBorder       bool
 	ResponsiveConfig *ResponsiveConfig
 	useSmartSizing   bool
+	smartRatio       float64
+	scrollable       bool
+	scrollOffset     int
 }
 
 // NewBox creates a new box
@@ -131,14 +53,16 @@ func NewBox() *Box {
 		content:        make([]string, 0),
 		style:          BoxStyleDefault,
 		alignment:      BoxAlignLeft,
-		padding:        SmartPadding(),
+		paddingX:       SmartPadding(),
+		paddingY:       SmartPadding(),
 		width:          SmartWidth(0.9), // Use 90% of smart width
 		color:          nil,
-		borderColor:    DimColor,
-		titleColor:     BoldColor,
+		borderColor:    DefaultBorderColor,
+		titleColor:     DefaultTitleColor,
 		autoSize:       true,
 		showBorder:     true,
 		useSmartSizing: true,
+		smartRatio:     0.9,
 	}
 
 	return box
@@ -162,10 +86,21 @@ func (b *Box) WithAlignment(alignment BoxAlignment) *Box {
 	return b
 }
 
-// WithPadding sets the internal padding
+// WithPadding sets the internal padding on all sides
 func (b *Box) WithPadding(padding int) *Box {
-	if padding >= 0 {
-		b.padding = padding
+	return b.WithPaddingXY(padding, padding)
+}
+
+// WithPaddingXY sets the horizontal padding (spaces left and right) and the
+// vertical padding (blank lines top and bottom) separately
+func (b *Box) WithPaddingXY(horizontal, vertical int) *Box {
+	if horizontal >= 0 {
+		b.paddingX = horizontal
+		b.customPadding = true
+	}
+	if vertical >= 0 {
+		b.paddingY = vertical
+		b.customPadding = true
 	}
 	return b
 }
@@ -183,6 +118,7 @@ func (b *Box) WithWidth(width int) *Box {
 // WithSmartWidth enables smart responsive width sizing
 func (b *Box) WithSmartWidth(percentage float64) *Box {
 	b.width = SmartWidth(percentage)
+	b.smartRatio = percentage
 	b.useSmartSizing = true
 	b.autoSize = false
 	return b
@@ -234,6 +170,27 @@ func (b *Box) ShowBorder(show bool) *Box {
 	return b
 }
 
+// Scrollable controls whether content taller than a fixed height is shown as a
+// scrolling window with an indicator in the right border instead of being cut off
+func (b *Box) Scrollable(enable bool) *Box {
+	b.scrollable = enable
+	return b
+}
+
+// ScrollTo scrolls a scrollable box so the given content line is at the top
+func (b *Box) ScrollTo(line int) *Box {
+	if line < 0 {
+		line = 0
+	}
+	b.scrollOffset = line
+	return b
+}
+
+// ScrollBy scrolls a scrollable box by delta lines; negative values scroll up
+func (b *Box) ScrollBy(delta int) *Box {
+	return b.ScrollTo(b.scrollOffset + delta)
+}
+
 // AddLine adds a single line of content
 func (b *Box) AddLine(line string) *Box {
 	b.content = append(b.content, line)
@@ -246,14 +203,14 @@ func (b *Box) AddLines(lines ...string) *Box {
 	return b
 }
 
-// AddText adds text content, automatically wrapping long lines
+// AddText adds text content, wrapping long lines and keeping explicit newlines
 func (b *Box) AddText(text string) *Box {
-	if text == "" {
+	if strings.TrimSpace(text) == "" {
 		b.content = append(b.content, "")
 		return b
 	}
 
-	availableWidth := b.width - (b.padding * 2)
+	availableWidth := b.width - (b.paddingX * 2)
 	if b.showBorder {
 		availableWidth -= 2
 	}
@@ -262,8 +219,30 @@ func (b *Box) AddText(text string) *Box {
 		availableWidth = 20
 	}
 
-	lines := wrapText(text, availableWidth)
-	b.content = append(b.content, lines...)
+	b.content = append(b.content, WrapTextHard(text, availableWidth)...)
+	return b
+}
+
+// AddBox renders another box and adds its lines as content, keeping the inner
+// borders intact. A smart-sized inner box is narrowed to fit inside this one
+func (b *Box) AddBox(inner *Box) *Box {
+	if inner == nil {
+		return b
+	}
+
+	availableWidth := b.width - (b.paddingX * 2)
+	if b.showBorder {
+		availableWidth -= 2
+	}
+
+	rendered := inner
+	if inner.useSmartSizing && availableWidth > 0 {
+		sized := *inner
+		sized.WithWidth(availableWidth)
+		rendered = &sized
+	}
+
+	b.content = append(b.content, strings.Split(rendered.Render(), "\n")...)
 	return b
 }
 
@@ -275,7 +254,7 @@ func (b *Box) AddEmptyLine() *Box {
 
 // AddSeparator adds a horizontal separator line
 func (b *Box) AddSeparator() *Box {
-	availableWidth := b.width - (b.padding * 2)
+	availableWidth := b.width - (b.paddingX * 2)
 	if b.showBorder {
 		availableWidth -= 2
 	}
@@ -300,6 +279,9 @@ func (b *Box) Render() string {
 	if b.useSmartSizing {
 		rm := GetResponsiveManager()
 		rm.RefreshBreakpoint()
+		if !b.autoSize {
+			b.width = SmartWidth(b.smartRatio)
+		}
 	}
 
 	if b.autoSize {
@@ -314,8 +296,13 @@ func (b *Box) Render() string {
 	}
 
 	contentLines := b.prepareContentLines()
-	for _, line := range contentLines {
-		result.WriteString(b.renderContentLine(line))
+	scrollbar := b.scrollbar(len(contentLines))
+	for i, line := range contentLines {
+		rightBorder := b.style.Vertical
+		if scrollbar != nil {
+			rightBorder = scrollbar[i]
+		}
+		result.WriteString(b.renderContentLine(line, rightBorder))
 		result.WriteString("\n")
 	}
 
@@ -328,12 +315,18 @@ func (b *Box) Render() string {
 
 // Print renders and prints the box
 func (b *Box) Print() {
-	fmt.Print(b.Render())
+	fmt.Fprint(outputWriter(), b.Render())
 }
 
 // Println renders and prints the box with a newline
 func (b *Box) Println() {
-	fmt.Println(b.Render())
+	fmt.Fprintln(outputWriter(), b.Render())
+}
+
+// FitsTerminal reports whether the rendered box fits the terminal width
+// and by how many columns it overflows when it does not
+func (b *Box) FitsTerminal() (bool, int) {
+	return measureOverflow(b.Render())
 }
 
 // calculateSize automatically calculates the optimal box size
@@ -351,19 +344,24 @@ func (b *Box) calculateSize() {
 			}
 
 			if config.Padding != nil {
-				b.padding = *config.Padding
+				b.paddingX = *config.Padding
+				b.paddingY = *config.Padding
 			}
 
 			if config.Compact {
-				b.padding = min(b.padding, 1)
+				b.paddingX = min(b.paddingX, 1)
+				b.paddingY = min(b.paddingY, 1)
 			}
 			return
 		}
 	}
 
 	if b.useSmartSizing {
-		b.width = SmartWidth(0.9)
-		b.padding = SmartPadding()
+		b.width = SmartWidth(b.smartRatio)
+		if !b.customPadding {
+			b.paddingX = SmartPadding()
+			b.paddingY = SmartPadding()
+		}
 	}
 
 	if len(b.content) == 0 {
@@ -382,19 +380,25 @@ func (b *Box) calculateSize() {
 	}
 
 	if !b.useSmartSizing {
-		requiredWidth := maxLineLength + (b.padding * 2)
+		requiredWidth := maxLineLength + (b.paddingX * 2)
 		if b.showBorder {
 			requiredWidth += 2
 		}
 
-		if b.title != "" && getVisualWidth(b.title)+4 > requiredWidth {
-			requiredWidth = getVisualWidth(b.title) + 4
+		if b.title != "" {
+			titleWidth := getVisualWidth(b.title) + 4
+			if b.showBorder {
+				titleWidth += 2
+			}
+			if titleWidth > requiredWidth {
+				requiredWidth = titleWidth
+			}
 		}
 
 		b.width = requiredWidth
 	}
 
-	b.height = len(b.content) + (b.padding * 2)
+	b.height = len(b.content) + (b.paddingY * 2)
 	if b.showBorder {
 		b.height += 2
 	}
@@ -404,15 +408,20 @@ func (b *Box) calculateSize() {
 func (b *Box) prepareContentLines() []string {
 	var lines []string
 
-	for i := 0; i < b.padding; i++ {
+	for i := 0; i < b.paddingY; i++ {
 		lines = append(lines, "")
 	}
 
-	for _, line := range b.content {
+	content := b.content
+	if window, ok := b.scrollWindow(); ok {
+		content = content[b.scrollOffset : b.scrollOffset+window]
+	}
+
+	for _, line := range content {
 		lines = append(lines, line)
 	}
 
-	for i := 0; i < b.padding; i++ {
+	for i := 0; i < b.paddingY; i++ {
 		lines = append(lines, "")
 	}
 
@@ -434,6 +443,61 @@ func (b *Box) prepareContentLines() []string {
 	return lines
 }
 
+// scrollWindow returns how many content lines a scrollable box can show, clamping
+// the scroll offset to the content. ok is false when the content needs no scrolling
+func (b *Box) scrollWindow() (int, bool) {
+	if !b.scrollable || b.autoSize || b.height <= 0 {
+		return 0, false
+	}
+
+	window := b.height - b.paddingY*2
+	if b.showBorder {
+		window -= 2
+	}
+	if window <= 0 || len(b.content) <= window {
+		return 0, false
+	}
+
+	maxOffset := len(b.content) - window
+	if b.scrollOffset > maxOffset {
+		b.scrollOffset = maxOffset
+	}
+	return window, true
+}
+
+// scrollbar returns the right border character for each of the rendered content rows,
+// or nil when the box is not scrolling
+func (b *Box) scrollbar(rows int) []string {
+	window, ok := b.scrollWindow()
+	if !ok || !b.showBorder {
+		return nil
+	}
+
+	bar := make([]string, rows)
+	for i := range bar {
+		bar[i] = b.style.Vertical
+	}
+
+	first := b.paddingY
+	last := min(b.paddingY+window, rows) - 1
+	maxOffset := len(b.content) - window
+
+	thumb := first
+	if window > 1 {
+		thumb = first + b.scrollOffset*(last-first)/maxOffset
+	}
+	bar[thumb] = "█"
+
+	if b.scrollOffset > 0 {
+		bar[first] = "▲"
+	}
+	if b.scrollOffset < maxOffset {
+		bar[last] = "▼"
+	}
+
+	return bar
+}
+
 // renderTopBorder renders the top border with optional title
 func (b *Box) renderTopBorder() string {
 	borderWidth := b.width
@@ -445,12 +509,12 @@ func (b *Box) renderTopBorder() string {
 
 	if b.title != "" {
 		titleLen := getVisualWidth(b.title)
-		if titleLen+4 >= borderWidth {
+		if titleLen+4 > borderWidth {
 			maxTitleLen := borderWidth - 4
 			if maxTitleLen > 0 {
 				title := TruncateString(b.title, maxTitleLen)
-				leftPart := b.style.TopLeft + "─"
-				rightPart := "─" + strings.Repeat(b.style.Horizontal, borderWidth-getVisualWidth(title)-2) + b.style.TopRight
+				leftPart := b.style.TopLeft + b.style.Horizontal + " "
+				rightPart := " " + strings.Repeat(b.style.Horizontal, borderWidth-getVisualWidth(title)-3) + b.style.TopRight
 
 				if b.borderColor != nil {
 					leftPart = b.borderColor.Sprint(leftPart)
@@ -512,8 +576,8 @@ func (b *Box) renderBottomBorder() string {
 	return border
 }
 
-// renderContentLine renders a single content line
-func (b *Box) renderContentLine(line string) string {
+// renderContentLine renders a single content line with the given right border character
+func (b *Box) renderContentLine(line, rightBorder string) string {
 	availableWidth := b.width
 	if b.showBorder {
 		availableWidth -= 2
@@ -523,27 +587,41 @@ func (b *Box) renderContentLine(line string) string {
 		availableWidth = 1
 	}
 
+	paddingX := b.paddingX
+	if availableWidth-paddingX*2 < 1 {
+		paddingX = 0
+	}
+	availableWidth -= paddingX * 2
+
 	if getVisualWidth(line) > availableWidth {
 		line = TruncateString(line, availableWidth)
 	}
 
+	// Close any color left open by the content (e.g. a colored line split by AddText)
+	// so it does not bleed into the padding and right border
+	if strings.Contains(line, "\033[") && !strings.HasSuffix(line, Reset) {
+		line += Reset
+	}
+
 	alignedLine := b.alignText(line, availableWidth)
 
 	// Ensure alignedLine is exactly the right width
 	if getVisualWidth(alignedLine) > availableWidth {
 		alignedLine = TruncateString(alignedLine, availableWidth)
-	} else if getVisualWidth(alignedLine) < availableWidth {
-		alignedLine = alignedLine + strings.Repeat(" ", availableWidth-getVisualWidth(alignedLine))
+	} else {
+		alignedLine = PadString(alignedLine, availableWidth)
 	}
 
 	if b.color != nil {
 		alignedLine = b.color.Sprint(alignedLine)
 	}
 
+	sidePadding := strings.Repeat(" ", paddingX)
+	alignedLine = sidePadding + alignedLine + sidePadding
+
 	var result string
 	if b.showBorder {
 		leftBorder := b.style.Vertical
-		rightBorder := b.style.Vertical
 
 		if b.borderColor != nil {
 			leftBorder = b.borderColor.Sprint(leftBorder)
@@ -579,8 +657,15 @@ func (b *Box) alignText(text string, width int) string {
 	}
 }
 
-// wrapText wraps text to fit within the specified width
+// wrapText wraps text to fit within the specified width, breaking words wider than it
 func wrapText(text string, width int) []string {
+	return wrapWords(text, width, true)
+}
+
+// wrapWords wraps the words of text into lines of at most width columns. Words
+// wider than width are broken into pieces when breakLong is set and otherwise
+// given a line of their own
+func wrapWords(text string, width int, breakLong bool) []string {
 	if width <= 0 {
 		return []string{text}
 	}
@@ -594,6 +679,17 @@ func wrapText(text string, width int) []string {
 	var currentLine strings.Builder
 
 	for _, word := range words {
+		if breakLong && getVisualWidth(word) > width {
+			if currentLine.Len() > 0 {
+				lines = append(lines, currentLine.String())
+				currentLine.Reset()
+			}
+			chunks := breakWord(word, width)
+			lines = append(lines, chunks[:len(chunks)-1]...)
+			currentLine.WriteString(chunks[len(chunks)-1])
+			continue
+		}
+
 		if currentLine.Len() == 0 {
 			currentLine.WriteString(word)
 		} else if getVisualWidth(currentLine.String())+1+getVisualWidth(word) <= width {
@@ -612,6 +708,20 @@ func wrapText(text string, width int) []string {
 	return lines
 }
 
+// breakWord splits a word that is wider than width into chunks of at most width columns
+func breakWord(word string, width int) []string {
+	var chunks []string
+	for getVisualWidth(word) > width {
+		head := truncateToVisualWidth(word, width)
+		if head == "" {
+			break
+		}
+		chunks = append(chunks, head)
+		word = removeANSIEscapeCodes(word)[len(head):]
+	}
+	return append(chunks, word)
+}
+
 // SimpleBox creates a simple box with content
 func SimpleBox(title, content string) string {
 	return NewBox().
@@ -662,25 +772,25 @@ func SuccessBox(title, content string) string {
 
 // PrintSimpleBox prints a simple box
 func PrintSimpleBox(title, content string) {
-	fmt.Print(SimpleBox(title, content))
+	fmt.Fprint(outputWriter(), SimpleBox(title, content))
 }
 
 // PrintInfoBox prints an info box
 func PrintInfoBox(title, content string) {
-	fmt.Print(InfoBox(title, content))
+	fmt.Fprint(outputWriter(), InfoBox(title, content))
 }
 
-// PrintWarningBox prints a warning box
+// PrintWarningBox prints a warning box to the error output
 func PrintWarningBox(title, content string) {
-	fmt.Print(WarningBox(title, content))
+	fmt.Fprint(diagnosticWriter(), WarningBox(title, content))
 }
 
-// PrintErrorBox prints an error box
+// PrintErrorBox prints an error box to the error output
 func PrintErrorBox(title, content string) {
-	fmt.Print(ErrorBox(title, content))
+	fmt.Fprint(diagnosticWriter(), ErrorBox(title, content))
 }
 
 // PrintSuccessBox prints a success box
 func PrintSuccessBox(title, content string) {
-	fmt.Print(SuccessBox(title, content))
+	fmt.Fprint(outputWriter(), SuccessBox(title, content))
 }
@@ -0,0 +1,67 @@
+package clime
+
+import (
+	"strings"
+	"testing"
+)
+
+// assertBoxLinesEqualWidth checks that every line of a rendered box, and so its
+// right border, has the same visual width
+func assertBoxLinesEqualWidth(t *testing.T, rendered string) []string {
+	t.Helper()
+
+	lines := strings.Split(rendered, "\n")
+	want := getVisualWidth(lines[0])
+	for i, line := range lines {
+		if got := getVisualWidth(line); got != want {
+			t.Errorf("line %d is %d columns wide, want %d: %q", i, got, want, removeANSIEscapeCodes(line))
+		}
+	}
+	return lines
+}
+
+func TestBoxAlignsColoredAndEmojiLines(t *testing.T) {
+	rendered := NewBox().
+		WithWidth(30).
+		AddLine(RGB(255, 0, 0).Enable().Sprint("colored text")).
+		AddLine("🚀 launched 日本").
+		AddLine("plain").
+		Render()
+
+	assertBoxLinesEqualWidth(t, rendered)
+}
+
+func TestBoxEmojiTitle(t *testing.T) {
+	for _, box := range []*Box{
+		NewBox().WithTitle("📊 System Resources").AddLine("cpu 42%"),
+		NewBox().WithTitle("📊 System Resources with a title too long to fit").WithWidth(24).AddLine("cpu 42%"),
+	} {
+		lines := assertBoxLinesEqualWidth(t, box.Render())
+		if !strings.Contains(lines[0], "📊") {
+			t.Errorf("top border %q lost the title", lines[0])
+		}
+	}
+}
+
+func TestBoxWrapsLongURL(t *testing.T) {
+	url := "https://example.com/" + strings.Repeat("a1b2c3d4e5", 18)
+	if len(url) != 200 {
+		t.Fatalf("url is %d characters, want 200", len(url))
+	}
+
+	rendered := NewBox().WithWidth(40).AddText("Download from " + url).Render()
+	lines := assertBoxLinesEqualWidth(t, rendered)
+	for i, line := range lines {
+		if width := getVisualWidth(line); width > 40 {
+			t.Errorf("line %d is %d columns wide, want at most 40", i, width)
+		}
+	}
+
+	var content strings.Builder
+	for _, line := range lines[1 : len(lines)-1] {
+		content.WriteString(strings.TrimSpace(strings.Trim(removeANSIEscapeCodes(line), "│")))
+	}
+	if !strings.Contains(content.String(), url) {
+		t.Error("wrapped lines do not contain the whole URL")
+	}
+}
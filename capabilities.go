@@ -0,0 +1,71 @@
+package clime
+
+import (
+	"os"
+	"runtime"
+	"strings"
+)
+
+// Capabilities describes what the terminal supports, as reported by TerminalCapabilities
+type Capabilities struct {
+	// Truecolor is set when the terminal accepts 24-bit RGB colors, as used by RGB and Hex
+	Truecolor bool
+	// Color256 is set when the terminal accepts the 256-color palette; it is implied by Truecolor
+	Color256 bool
+	// UTF8 is set when the locale is UTF-8, so box drawing characters and icons display
+	UTF8 bool
+	// Interactive is set when output goes to a terminal, or ForceInteractive(true) was called
+	Interactive bool
+	Width       int
+	Height      int
+}
+
+// TerminalCapabilities probes the terminal from $TERM, $COLORTERM, the locale
+// ($LC_ALL, $LC_CTYPE, $LANG) and whether output is a terminal. It is meant for
+// choosing fallbacks, such as ASCII borders, and for diagnosing rendering problems
+func TerminalCapabilities() Capabilities {
+	terminal := GetTerminal()
+	terminal.Refresh()
+
+	caps := Capabilities{
+		UTF8:        localeIsUTF8(),
+		Interactive: isTerminalWriter(outputWriter()),
+		Width:       terminal.Width(),
+		Height:      terminal.Height(),
+	}
+
+	termEnv := strings.ToLower(os.Getenv("TERM"))
+	if termEnv == "dumb" {
+		return caps
+	}
+
+	colorTerm := strings.ToLower(os.Getenv("COLORTERM"))
+	windowsTerminal := runtime.GOOS == "windows" && os.Getenv("WT_SESSION") != ""
+
+	caps.Truecolor = colorTerm == "truecolor" || colorTerm == "24bit" || strings.Contains(termEnv, "direct") || windowsTerminal
+	caps.Color256 = caps.Truecolor || strings.Contains(termEnv, "256color")
+	caps.UTF8 = caps.UTF8 || windowsTerminal
+
+	return caps
+}
+
+// BorderStyle returns style when the terminal can display it, or BorderStyleSimple
+// when the locale is not UTF-8
+func (c Capabilities) BorderStyle(style BorderStyle) BorderStyle {
+	if c.UTF8 {
+		return style
+	}
+	return BorderStyleSimple
+}
+
+// localeIsUTF8 reports whether the effective locale uses UTF-8. As with setlocale,
+// the first of $LC_ALL, $LC_CTYPE and $LANG that is set decides
+func localeIsUTF8() bool {
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := os.Getenv(name); locale != "" {
+			locale = strings.ToLower(locale)
+			return strings.Contains(locale, "utf-8") || strings.Contains(locale, "utf8")
+		}
+	}
+	return false
+}
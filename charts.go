@@ -39,11 +39,18 @@ func NewBarChart(title string) *BarChart {
 	}
 }
 
+// defaultChartColor picks the nth color from the theme's chart palette
+func defaultChartColor(n int) *Color {
+	if len(DefaultChartColors) == 0 {
+		return BlueColor
+	}
+	return DefaultChartColors[n%len(DefaultChartColors)]
+}
+
 // AddData adds data to the chart
 func (bc *BarChart) AddData(label string, value float64, color *Color) *BarChart {
 	if color == nil {
-		colors := []*Color{BlueColor, GreenColor, YellowColor, RedColor, MagentaColor, CyanColor}
-		color = colors[len(bc.Data)%len(colors)]
+		color = defaultChartColor(len(bc.Data))
 	}
 
 	bc.Data = append(bc.Data, ChartData{Label: label, Value: value, Color: color})
@@ -96,12 +103,12 @@ func (bc *BarChart) WithResponsiveConfig(config ResponsiveConfig) *BarChart {
 
 // Print renders and prints the chart
 func (bc *BarChart) Print() {
-	fmt.Print(bc.Render())
+	fmt.Fprint(outputWriter(), bc.Render())
 }
 
 // Println renders and prints the chart with newline
 func (bc *BarChart) Println() {
-	fmt.Println(bc.Render())
+	fmt.Fprintln(outputWriter(), bc.Render())
 }
 
 // Render generates the chart string
@@ -114,7 +121,7 @@ func (bc *BarChart) Render() string {
 
 	if bc.Title != "" {
 		titleLine := fmt.Sprintf("%s", bc.Title)
-		result.WriteString(BoldColor.Sprint(titleLine) + "\n\n")
+		result.WriteString(DefaultTitleColor.Sprint(titleLine) + "\n\n")
 	}
 
 	if bc.Horizontal {
@@ -142,21 +149,41 @@ func (bc *BarChart) renderHorizontal() string {
 		barWidth = 10
 	}
 
+	// Negative values extend left of a zero baseline, positive values to its right
+	minValue := 0.0
+	for _, data := range bc.Data {
+		if data.Value < minValue {
+			minValue = data.Value
+		}
+	}
+	maxValue := math.Max(bc.MaxValue, 0)
+
+	negativeWidth := 0
+	if span := maxValue - minValue; span > 0 {
+		negativeWidth = int(-minValue / span * float64(barWidth))
+	}
+	positiveWidth := barWidth - negativeWidth
+
 	for _, data := range bc.Data {
 		label := PadString(data.Label, maxLabelWidth)
 		result.WriteString(label + " ")
 
-		percentage := data.Value / bc.MaxValue
-		barLength := int(percentage * float64(barWidth))
-
-		bar := strings.Repeat("█", barLength)
-		bar += strings.Repeat("░", barWidth-barLength)
+		var bar string
+		if data.Value < 0 {
+			barLength := scaledBarLength(-data.Value, -minValue, negativeWidth)
+			bar = strings.Repeat("░", negativeWidth-barLength) + strings.Repeat("█", barLength)
+			bar += strings.Repeat("░", positiveWidth)
+		} else {
+			barLength := scaledBarLength(data.Value, maxValue, positiveWidth)
+			bar = strings.Repeat("░", negativeWidth) + strings.Repeat("█", barLength)
+			bar += strings.Repeat("░", positiveWidth-barLength)
+		}
 
 		result.WriteString(data.Color.Sprint(bar))
 
 		if bc.ShowValues {
 			valueStr := fmt.Sprintf(" %.1f", data.Value)
-			result.WriteString(DimColor.Sprint(valueStr))
+			result.WriteString(Muted.Sprint(valueStr))
 		}
 
 		result.WriteString("\n")
@@ -165,7 +192,20 @@ func (bc *BarChart) renderHorizontal() string {
 	return result.String()
 }
 
-// renderVertical renders vertical bar chart
+// scaledBarLength scales value against limit to a bar length clamped to [0, width]
+func scaledBarLength(value, limit float64, width int) int {
+	if limit <= 0 || value <= 0 {
+		return 0
+	}
+
+	length := int(value / limit * float64(width))
+	if length > width {
+		length = width
+	}
+	return length
+}
+
+// renderVertical renders vertical bar chart. Bars for zero or negative values are left empty
 func (bc *BarChart) renderVertical() string {
 	var result strings.Builder
 
@@ -183,7 +223,7 @@ func (bc *BarChart) renderVertical() string {
 				result.WriteString(" ")
 			}
 
-			if data.Value >= threshold {
+			if bc.MaxValue > 0 && data.Value > 0 && data.Value >= threshold {
 				bar := strings.Repeat("█", barWidth)
 				result.WriteString(data.Color.Sprint(bar))
 			} else {
@@ -214,7 +254,7 @@ func (bc *BarChart) renderVertical() string {
 			valueStr := fmt.Sprintf("%.1f", data.Value)
 			valueStr = TruncateString(valueStr, barWidth)
 			valueStr = PadString(valueStr, barWidth)
-			result.WriteString(DimColor.Sprint(valueStr))
+			result.WriteString(Muted.Sprint(valueStr))
 		}
 		result.WriteString("\n")
 	}
@@ -246,8 +286,7 @@ func NewPieChart(title string) *PieChart {
 // AddData adds data to the pie chart
 func (pc *PieChart) AddData(label string, value float64, color *Color) *PieChart {
 	if color == nil {
-		colors := []*Color{BlueColor, GreenColor, YellowColor, RedColor, MagentaColor, CyanColor}
-		color = colors[len(pc.Data)&len(colors)]
+		color = defaultChartColor(len(pc.Data))
 	}
 
 	pc.Data = append(pc.Data, ChartData{
@@ -279,12 +318,12 @@ func (pc *PieChart) SetShowLegend(show bool) *PieChart {
 
 // Print renders and prints the pie chart
 func (pc *PieChart) Print() {
-	fmt.Print(pc.Render())
+	fmt.Fprint(outputWriter(), pc.Render())
 }
 
 // Println renders and prints the pie chart with newline
 func (pc *PieChart) Println() {
-	fmt.Println(pc.Render())
+	fmt.Fprintln(outputWriter(), pc.Render())
 }
 
 // Render generates the pie chart string
@@ -297,7 +336,7 @@ func (pc *PieChart) Render() string {
 
 	if pc.Title != "" {
 		titleLine := fmt.Sprintf("%s", pc.Title)
-		result.WriteString(BoldColor.Sprintf(titleLine) + "\n\n")
+		result.WriteString(DefaultTitleColor.Sprint(titleLine) + "\n\n")
 	}
 
 	total := 0.0
@@ -305,6 +344,10 @@ func (pc *PieChart) Render() string {
 		total += data.Value
 	}
 
+	if total <= 0 {
+		return result.String()
+	}
+
 	effectiveRadius := float64(pc.Radius)
 	size := int(effectiveRadius * 2.2)
 
@@ -412,7 +455,7 @@ func NewHistogram(title string, data []float64) *Histogram {
 		Data:  data,
 		Bins:  10,
 		Width: SmartWidth(0.8),
-		Color: BlueColor,
+		Color: defaultChartColor(0),
 	}
 }
 
@@ -436,12 +479,12 @@ func (h *Histogram) WithColor(color *Color) *Histogram {
 
 // Print renders and prints the histogram
 func (h *Histogram) Print() {
-	fmt.Print(h.Render())
+	fmt.Fprint(outputWriter(), h.Render())
 }
 
 // Println renders and prints the histogram with newline
 func (h *Histogram) Println() {
-	fmt.Println(h.Render())
+	fmt.Fprintln(outputWriter(), h.Render())
 }
 
 // Render generates the histogram string
@@ -454,7 +497,12 @@ func (h *Histogram) Render() string {
 
 	if h.Title != "" {
 		titleLine := fmt.Sprintf("📈 %s", h.Title)
-		result.WriteString(BoldColor.Sprint(titleLine) + "\n\n")
+		result.WriteString(DefaultTitleColor.Sprint(titleLine) + "\n\n")
+	}
+
+	bins := h.Bins
+	if bins < 1 {
+		bins = 1
 	}
 
 	minimum, maximum := h.Data[0], h.Data[0]
@@ -467,13 +515,16 @@ func (h *Histogram) Render() string {
 		}
 	}
 
-	binWidth := (maximum - minimum) / float64(h.Bins)
-	counts := make([]int, h.Bins)
+	binWidth := (maximum - minimum) / float64(bins)
+	counts := make([]int, bins)
 
 	for _, value := range h.Data {
-		binIndex := int((value - minimum) / binWidth)
-		if binIndex >= h.Bins {
-			binIndex = h.Bins - 1
+		binIndex := 0
+		if binWidth > 0 {
+			binIndex = int((value - minimum) / binWidth)
+		}
+		if binIndex >= bins {
+			binIndex = bins - 1
 		}
 		counts[binIndex]++
 	}
@@ -485,43 +536,279 @@ func (h *Histogram) Render() string {
 		}
 	}
 
-	barWidth := (h.Width - 20) / h.Bins
-	if barWidth < 1 {
-		barWidth = 1
+	labels := make([]string, bins)
+	maxLabelWidth := 0
+	for i := range labels {
+		binStart := minimum + float64(i)*binWidth
+		labels[i] = fmt.Sprintf("%.1f - %.1f", binStart, binStart+binWidth)
+		if getVisualWidth(labels[i]) > maxLabelWidth {
+			maxLabelWidth = getVisualWidth(labels[i])
+		}
+	}
+
+	countWidth := len(fmt.Sprintf("%d", maxCount))
+	barWidth := h.Width - maxLabelWidth - countWidth - 2
+	if barWidth < 10 {
+		barWidth = 10
 	}
 
-	height := 10
-	for row := height; row > 0; row-- {
-		threshold := (float64(row) / float64(height)) * float64(maxCount)
+	for i, count := range counts {
+		result.WriteString(Muted.Sprint(PadString(labels[i], maxLabelWidth)) + " ")
 
-		for i, count := range counts {
-			if i > 0 {
+		barLength := 0
+		if maxCount > 0 {
+			barLength = int(float64(count) / float64(maxCount) * float64(barWidth))
+		}
+
+		bar := strings.Repeat("█", barLength)
+		if h.Color != nil {
+			bar = h.Color.Sprint(bar)
+		}
+		result.WriteString(bar)
+		result.WriteString(fmt.Sprintf(" %d\n", count))
+	}
+
+	return result.String()
+}
+
+// Sparkline renders data as a single line of block characters scaled between its minimum and maximum
+func Sparkline(data []float64) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	blocks := []rune("▁▂▃▄▅▆▇█")
+
+	minimum, maximum := data[0], data[0]
+	for _, value := range data {
+		if value < minimum {
+			minimum = value
+		}
+		if value > maximum {
+			maximum = value
+		}
+	}
+
+	var result strings.Builder
+	for _, value := range data {
+		level := 0
+		if maximum > minimum {
+			level = int(math.Round((value - minimum) / (maximum - minimum) * float64(len(blocks)-1)))
+		}
+		result.WriteRune(blocks[level])
+	}
+
+	return result.String()
+}
+
+// LineSeries represents a single series of a line chart
+type LineSeries struct {
+	Label string
+	Data  []float64
+	Color *Color
+}
+
+// LineChart represents a line chart drawn with Braille characters
+type LineChart struct {
+	Title      string
+	Series     []LineSeries
+	Width      int
+	Height     int
+	ShowLegend bool
+}
+
+// NewLineChart creates a new line chart
+func NewLineChart(title string) *LineChart {
+	return &LineChart{
+		Title:      title,
+		Series:     make([]LineSeries, 0),
+		Width:      SmartWidth(0.8),
+		Height:     10,
+		ShowLegend: true,
+	}
+}
+
+// AddSeries adds a data series to the chart
+func (lc *LineChart) AddSeries(label string, data []float64, color *Color) *LineChart {
+	if color == nil {
+		color = defaultChartColor(len(lc.Series))
+	}
+
+	lc.Series = append(lc.Series, LineSeries{Label: label, Data: data, Color: color})
+	return lc
+}
+
+// WithWidth sets the chart width
+func (lc *LineChart) WithWidth(width int) *LineChart {
+	lc.Width = width
+	return lc
+}
+
+// WithHeight sets the chart height in lines
+func (lc *LineChart) WithHeight(height int) *LineChart {
+	lc.Height = height
+	return lc
+}
+
+// SetShowLegend toggles legend display
+func (lc *LineChart) SetShowLegend(show bool) *LineChart {
+	lc.ShowLegend = show
+	return lc
+}
+
+// Print renders and prints the line chart
+func (lc *LineChart) Print() {
+	fmt.Fprint(outputWriter(), lc.Render())
+}
+
+// Println renders and prints the line chart with newline
+func (lc *LineChart) Println() {
+	fmt.Fprintln(outputWriter(), lc.Render())
+}
+
+// Render generates the line chart string
+func (lc *LineChart) Render() string {
+	minimum, maximum, ok := lc.valueRange()
+	if !ok {
+		return ""
+	}
+
+	var result strings.Builder
+
+	if lc.Title != "" {
+		result.WriteString(DefaultTitleColor.Sprint(lc.Title) + "\n\n")
+	}
+
+	height := lc.Height
+	if height < 2 {
+		height = 2
+	}
+
+	maxLabel := fmt.Sprintf("%.1f", maximum)
+	minLabel := fmt.Sprintf("%.1f", minimum)
+	labelWidth := max(getVisualWidth(maxLabel), getVisualWidth(minLabel))
+
+	width := lc.Width - labelWidth - 2
+	if width < 10 {
+		width = 10
+	}
+
+	// Each Braille character holds a 2x4 grid of dots
+	dots := make([][]rune, height)
+	colors := make([][]*Color, height)
+	for row := range dots {
+		dots[row] = make([]rune, width)
+		colors[row] = make([]*Color, width)
+	}
+
+	pixelWidth := width * 2
+	pixelHeight := height * 4
+
+	for _, series := range lc.Series {
+		if len(series.Data) == 0 {
+			continue
+		}
+
+		previousY := -1
+		for px := 0; px < pixelWidth; px++ {
+			value := sampleSeries(series.Data, float64(px)/float64(pixelWidth-1))
+
+			py := pixelHeight - 1
+			if maximum > minimum {
+				py = int(math.Round((maximum - value) / (maximum - minimum) * float64(pixelHeight-1)))
+			}
+
+			fromY, toY := py, py
+			if previousY >= 0 {
+				fromY, toY = min(previousY, py), max(previousY, py)
+			}
+
+			for y := fromY; y <= toY; y++ {
+				dots[y/4][px/2] |= brailleDot(px%2, y%4)
+				colors[y/4][px/2] = series.Color
+			}
+			previousY = py
+		}
+	}
+
+	for row := 0; row < height; row++ {
+		label := ""
+		switch row {
+		case 0:
+			label = maxLabel
+		case height - 1:
+			label = minLabel
+		}
+
+		result.WriteString(Muted.Sprint(strings.Repeat(" ", labelWidth-getVisualWidth(label))+label) + " " + Muted.Sprint("┤"))
+
+		for col := 0; col < width; col++ {
+			if dots[row][col] == 0 {
 				result.WriteString(" ")
+				continue
 			}
 
-			if float64(count) >= threshold {
-				bar := strings.Repeat("█", barWidth)
-				result.WriteString(h.Color.Sprint(bar))
-			} else {
-				bar := strings.Repeat(" ", barWidth)
-				result.WriteString(bar)
+			char := string(0x2800 + dots[row][col])
+			if colors[row][col] != nil {
+				char = colors[row][col].Sprint(char)
 			}
+			result.WriteString(char)
 		}
 		result.WriteString("\n")
 	}
 
-	for i := 0; i < h.Bins; i++ {
-		if i > 0 {
-			result.WriteString(" ")
-		}
+	result.WriteString(strings.Repeat(" ", labelWidth+1) + Muted.Sprint("└"+strings.Repeat("─", width)) + "\n")
 
-		binStart := minimum + float64(i)*binWidth
-		label := fmt.Sprintf("%.1f", binStart)
-		label = TruncateString(label, barWidth)
-		label = PadString(label, barWidth)
-		result.WriteString(DimColor.Sprint(label))
+	if lc.ShowLegend {
+		result.WriteString("\n")
+		for _, series := range lc.Series {
+			result.WriteString(fmt.Sprintf("  %s %s\n", series.Color.Sprint("━"), series.Label))
+		}
 	}
-	result.WriteString("\n")
 
 	return result.String()
 }
+
+// valueRange returns the minimum and maximum across all series
+func (lc *LineChart) valueRange() (float64, float64, bool) {
+	found := false
+	var minimum, maximum float64
+
+	for _, series := range lc.Series {
+		for _, value := range series.Data {
+			if !found || value < minimum {
+				minimum = value
+			}
+			if !found || value > maximum {
+				maximum = value
+			}
+			found = true
+		}
+	}
+
+	return minimum, maximum, found
+}
+
+// sampleSeries linearly interpolates a series at position t in [0, 1]
+func sampleSeries(data []float64, t float64) float64 {
+	if len(data) == 1 {
+		return data[0]
+	}
+
+	position := t * float64(len(data)-1)
+	index := int(position)
+	if index >= len(data)-1 {
+		return data[len(data)-1]
+	}
+
+	fraction := position - float64(index)
+	return data[index] + (data[index+1]-data[index])*fraction
+}
+
+// brailleDot returns the Braille bit for a dot at column x (0-1) and row y (0-3)
+func brailleDot(x, y int) rune {
+	if y == 3 {
+		return []rune{0x40, 0x80}[x]
+	}
+	return rune(1<<y) << (3 * x)
+}
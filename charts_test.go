@@ -0,0 +1,24 @@
+package clime
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPieChartCyclesPaletteColors(t *testing.T) {
+	palette := DefaultChartColors
+	chart := NewPieChart("Share")
+	for i := range 8 {
+		chart.AddData(fmt.Sprintf("slice %d", i), 1, nil)
+	}
+
+	for i, data := range chart.Data {
+		want := palette[i%len(palette)]
+		if data.Color != want {
+			t.Errorf("slice %d color = %v, want palette[%d]", i, data.Color, i%len(palette))
+		}
+		if i > 0 && i < len(palette) && data.Color == chart.Data[i-1].Color {
+			t.Errorf("slice %d repeats the previous color", i)
+		}
+	}
+}
@@ -3,11 +3,16 @@ package clime
 import (
 	"fmt"
 	"golang.org/x/term"
+	"io"
 	"os"
-	"os/exec"
+	"os/signal"
 	"regexp"
-	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -17,96 +22,372 @@ type Terminal struct {
 	width  int
 	height int
 	isATTY bool
+	mu     sync.RWMutex
 }
 
-// NewTerminal creates a new terminal instance
+var globalTerminal *Terminal
+var terminalOnce sync.Once
+
+// GetTerminal returns the shared terminal used by all components. Its size is
+// cached and only re-queried by Refresh, ResponsiveManager.RefreshBreakpoint or
+// WatchResize.
+//
+// Box, Banner, Table and ProgressBar with smart sizing refresh it once each time
+// they render, so they follow resizes. Widths set explicitly with WithWidth,
+// CustomBanner and the chart types keep the size they were created with.
+func GetTerminal() *Terminal {
+	terminalOnce.Do(func() {
+		globalTerminal = NewTerminal()
+	})
+
+	return globalTerminal
+}
+
+// NewTerminal creates a new terminal instance holding the size at the time of the call
 func NewTerminal() *Terminal {
-	width, height, _ := term.GetSize(int(os.Stdout.Fd()))
-	if width == 0 {
-		width = 80
-	}
-	if height == 0 {
-		height = 24
-	}
+	t := &Terminal{}
+	t.Refresh()
+	return t
+}
 
-	return &Terminal{
-		width:  width,
-		height: height,
-		isATTY: term.IsTerminal(int(os.Stdout.Fd())),
-	}
+// Refresh re-queries the terminal size and whether stdout is a terminal
+func (t *Terminal) Refresh() {
+	width, height := getTerminalSize()
+	isATTY := term.IsTerminal(int(os.Stdout.Fd()))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.width = width
+	t.height = height
+	t.isATTY = isATTY
 }
 
 // Width returns the terminal width
 func (t *Terminal) Width() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	return t.width
 }
 
 // Height returns the terminal height
 func (t *Terminal) Height() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	return t.height
 }
 
 // IsATTY returns true if stdout is a terminal
 func (t *Terminal) IsATTY() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	return t.isATTY
 }
 
 // Clear clears the terminal screen
 func Clear() {
-	fmt.Print("\033[2J\033[H")
+	if !isTerminalWriter(outputWriter()) {
+		return
+	}
+	fmt.Fprint(outputWriter(), "\033[2J\033[H")
 }
 
 // MoveCursorUp moves the cursor up by n lines
 func MoveCursorUp(n int) {
-	fmt.Printf("\033[%dA", n)
+	moveCursorUpTo(outputWriter(), n)
 }
 
 // MoveCursorDown moves the cursor down by n lines
 func MoveCursorDown(n int) {
-	fmt.Printf("\033[%dB", n)
+	if !isTerminalWriter(outputWriter()) {
+		return
+	}
+	fmt.Fprintf(outputWriter(), "\033[%dB", n)
 }
 
 // HideCursor hides the terminal cursor
 func HideCursor() {
-	fmt.Print("\033[?25l")
+	hideCursorTo(outputWriter())
 }
 
 // ShowCursor shows the terminal cursor
 func ShowCursor() {
-	fmt.Print("\033[?25h")
+	showCursorTo(outputWriter())
 }
 
 // ClearLine clears the current line
 func ClearLine() {
-	fmt.Print("\033[2K\r")
+	clearLineTo(outputWriter())
+}
+
+// The cursor helpers below write nothing when w is not a terminal, so redirected
+// output stays free of escape codes
+
+// moveCursorUpTo writes the sequence moving the cursor up by n lines to w
+func moveCursorUpTo(w io.Writer, n int) {
+	if !isTerminalWriter(w) {
+		return
+	}
+	fmt.Fprintf(w, "\033[%dA", n)
+}
+
+// hideCursorTo writes the sequence hiding the cursor to w
+func hideCursorTo(w io.Writer) {
+	if !isTerminalWriter(w) {
+		return
+	}
+	fmt.Fprint(w, "\033[?25l")
+}
+
+// showCursorTo writes the sequence showing the cursor to w
+func showCursorTo(w io.Writer) {
+	if !isTerminalWriter(w) {
+		return
+	}
+	fmt.Fprint(w, "\033[?25h")
+}
+
+// clearLineTo writes the sequence clearing the current line to w
+func clearLineTo(w io.Writer) {
+	if !isTerminalWriter(w) {
+		return
+	}
+	fmt.Fprint(w, "\033[2K\r")
+}
+
+// interruptHandlingDisabled is set when HandleInterrupt(false) has been called
+var interruptHandlingDisabled atomic.Bool
+
+// HandleInterrupt controls whether animated components that hide the cursor, such as
+// spinners, install a SIGINT/SIGTERM handler that shows the cursor again and then
+// re-raises the signal, so the process ends as it would have without the handler.
+// It is enabled by default; disable it when the application installs its own
+// signal handling, which would otherwise receive the signal twice
+func HandleInterrupt(enable bool) {
+	interruptHandlingDisabled.Store(!enable)
+}
+
+// restoreOnInterrupt shows the cursor on w if SIGINT or SIGTERM arrives before the
+// returned release function is called, then re-raises the signal with the handler
+// removed. Only where a process cannot signal itself does it exit directly
+func restoreOnInterrupt(w io.Writer) func() {
+	if interruptHandlingDisabled.Load() {
+		return func() {}
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		defer signal.Stop(sigCh)
+
+		select {
+		case sig := <-sigCh:
+			showCursorTo(w)
+			fmt.Fprintln(w)
+			signal.Stop(sigCh)
+
+			process, err := os.FindProcess(os.Getpid())
+			if err == nil {
+				err = process.Signal(sig)
+			}
+			if err != nil {
+				if sig == os.Interrupt {
+					os.Exit(130)
+				}
+				os.Exit(143)
+			}
+		case <-done:
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+var (
+	outputMu    sync.RWMutex
+	output      io.Writer = os.Stdout
+	errorOutput io.Writer = os.Stderr
+)
+
+// SetOutput redirects everything the package prints, from the Print helpers to
+// component Print methods, progress bars and new spinners, to w. Passing nil
+// restores os.Stdout. Prompts keep reading and echoing on the terminal
+func SetOutput(w io.Writer) {
+	if w == nil {
+		w = os.Stdout
+	}
+	outputMu.Lock()
+	defer outputMu.Unlock()
+	output = w
+}
+
+// SetErrorOutput redirects the package's error output to w. Passing nil restores os.Stderr
+func SetErrorOutput(w io.Writer) {
+	if w == nil {
+		w = os.Stderr
+	}
+	outputMu.Lock()
+	defer outputMu.Unlock()
+	errorOutput = w
 }
 
+// outputWriter returns the writer set by SetOutput
+func outputWriter() io.Writer {
+	outputMu.RLock()
+	defer outputMu.RUnlock()
+	return output
+}
+
+// errorOutputWriter returns the writer set by SetErrorOutput
+func errorOutputWriter() io.Writer {
+	outputMu.RLock()
+	defer outputMu.RUnlock()
+	return errorOutput
+}
+
+// errorsToStdout sends the error and warning helpers to the regular output
+var errorsToStdout atomic.Bool
+
+// ErrorsToStdout controls where ErrorLine, WarningLine, ErrorBanner, WarningBanner,
+// PrintErrorBox and PrintWarningBox write. By default they follow Unix convention
+// and use the error output (os.Stderr unless changed with SetErrorOutput); true
+// restores the old behaviour of writing them to the regular output
+func ErrorsToStdout(enabled bool) {
+	errorsToStdout.Store(enabled)
+}
+
+// diagnosticWriter returns the writer for error and warning helpers
+func diagnosticWriter() io.Writer {
+	if errorsToStdout.Load() {
+		return outputWriter()
+	}
+	return errorOutputWriter()
+}
+
+// interactiveOverride is 0 to detect terminals, 1 to force interactive output and 2 to force plain output
+var interactiveOverride atomic.Int32
+
+// ForceInteractive overrides terminal detection for progress bars, spinners and the
+// cursor helpers: true always redraws in place with escape codes, false always
+// writes plain lines. It is mainly meant for tests that render into buffers
+func ForceInteractive(enabled bool) {
+	if enabled {
+		interactiveOverride.Store(1)
+	} else {
+		interactiveOverride.Store(2)
+	}
+}
+
+// ResetInteractive undoes ForceInteractive, going back to detecting whether output
+// is a terminal. Tests that force a mode can defer it to avoid affecting each other
+func ResetInteractive() {
+	interactiveOverride.Store(0)
+}
+
+// isTerminalWriter reports whether w is a file attached to a terminal, unless
+// overridden by ForceInteractive
+func isTerminalWriter(w io.Writer) bool {
+	switch interactiveOverride.Load() {
+	case 1:
+		return true
+	case 2:
+		return false
+	}
+
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// ansiRegex matches SGR and other CSI sequences as well as OSC 8 hyperlink wrappers
+var ansiRegex = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]|\x1b\]8;[^\x1b\x07]*(?:\x1b\\|\x07)`)
+
 // removeANSIEscapeCodes removes ANSI escape codes from a string
 func removeANSIEscapeCodes(s string) string {
-	ansiRegex := regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
 	return ansiRegex.ReplaceAllString(s, "")
 }
 
 // getVisualWidth calculates the actual visual width of a string
 func getVisualWidth(s string) int {
-	cleanStr := removeANSIEscapeCodes(s)
-	
 	width := 0
-	for len(cleanStr) > 0 {
-		r, size := utf8.DecodeRuneInString(cleanStr)
-		if r == utf8.RuneError {
-			width++
-		} else {
-			if isWideChar(r) {
-				width += 2
-			} else {
-				width++
+	for _, cluster := range splitClusters(removeANSIEscapeCodes(s)) {
+		width += clusterWidth(cluster)
+	}
+	return width
+}
+
+// splitClusters splits s into the character sequences a terminal draws as one
+// glyph: a base character with its combining marks, variation selectors and
+// skin tone modifiers, emoji joined by zero-width joiners, and flag pairs
+func splitClusters(s string) []string {
+	var clusters []string
+	start := 0
+	var prev rune
+	regionalCount := 0
+
+	for i, r := range s {
+		if i > start {
+			joins := isZeroWidth(r) || isSkinToneModifier(r) || prev == zeroWidthJoiner ||
+				(isRegionalIndicator(r) && regionalCount == 1)
+			if !joins {
+				clusters = append(clusters, s[start:i])
+				start = i
+				regionalCount = 0
 			}
 		}
-		cleanStr = cleanStr[size:]
+		if isRegionalIndicator(r) {
+			regionalCount++
+		}
+		prev = r
 	}
-	
-	return width
+	if start < len(s) {
+		clusters = append(clusters, s[start:])
+	}
+
+	return clusters
+}
+
+// clusterWidth returns the columns taken by a cluster from splitClusters, which
+// is the width of its base character; a pair of regional indicators is a flag
+func clusterWidth(cluster string) int {
+	r, size := utf8.DecodeRuneInString(cluster)
+	switch {
+	case r == utf8.RuneError:
+		return 1
+	case isRegionalIndicator(r):
+		if len(cluster) > size {
+			return 2
+		}
+		return 1
+	case isZeroWidth(r):
+		return 0
+	case isWideChar(r):
+		return 2
+	}
+	return 1
+}
+
+const zeroWidthJoiner = '\u200D'
+
+// isZeroWidth reports whether r takes no columns of its own: combining marks,
+// format characters such as the zero-width joiner, and variation selectors
+func isZeroWidth(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) ||
+		(r >= 0xFE00 && r <= 0xFE0F) || // Variation Selectors
+		(r >= 0xE0100 && r <= 0xE01EF) // Variation Selectors Supplement
+}
+
+// isSkinToneModifier reports whether r is an emoji skin tone modifier
+func isSkinToneModifier(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
+
+// isRegionalIndicator reports whether r is a regional indicator letter, two of which form a flag
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
 }
 
 // isWideChar checks if a Unicode character takes 2 columns in terminal
@@ -143,6 +424,7 @@ func isWideChar(r rune) bool {
 		(r >= 0x1F780 && r <= 0x1F7FF) || // Geometric Shapes Extended
 		(r >= 0x1F800 && r <= 0x1F8FF) || // Supplemental Arrows-C
 		(r >= 0x1F900 && r <= 0x1F9FF) || // Supplemental Symbols and Pictographs
+		(r >= 0x1FA70 && r <= 0x1FAFF) || // Symbols and Pictographs Extended-A
 		(r >= 0x20000 && r <= 0x2A6DF) || // CJK Unified Ideographs Extension B
 		(r >= 0x2A700 && r <= 0x2B73F) || // CJK Unified Ideographs Extension C
 		(r >= 0x2B740 && r <= 0x2B81F) || // CJK Unified Ideographs Extension D
@@ -150,6 +432,17 @@ func isWideChar(r rune) bool {
 		(r >= 0x2CEB0 && r <= 0x2EBEF)    // CJK Unified Ideographs Extension F
 }
 
+// StripANSI removes ANSI escape codes from a string, e.g. before writing colored output to a log file
+func StripANSI(s string) string {
+	return removeANSIEscapeCodes(s)
+}
+
+// VisualWidth returns the number of terminal columns a string occupies, ignoring ANSI codes
+// and counting wide characters as two columns
+func VisualWidth(s string) int {
+	return getVisualWidth(s)
+}
+
 // PadString pads a string to the specified width using visual width calculation
 func PadString(s string, width int) string {
 	visualWidth := getVisualWidth(s)
@@ -162,16 +455,83 @@ func PadString(s string, width int) string {
 
 // TruncateString truncates a string to the specified width with ellipsis using visual width calculation
 func TruncateString(s string, width int) string {
+	return truncateWithEllipsis(s, width, "...")
+}
+
+// WrapText wraps text into lines of at most width columns, measured with ANSI codes
+// ignored and wide characters counted as two. Lines break between words; a word
+// wider than width is kept whole on its own line. Newlines start a new paragraph,
+// and blank lines are kept. Whitespace at the start and end of each line is
+// dropped and runs of spaces collapse to one. Empty or blank text gives no lines,
+// and a width of 0 or less only splits on newlines
+func WrapText(text string, width int) []string {
+	return wrapTextLines(text, width, false)
+}
+
+// WrapTextHard wraps text like WrapText but breaks words wider than width across lines
+func WrapTextHard(text string, width int) []string {
+	return wrapTextLines(text, width, true)
+}
+
+// wrapTextLines wraps each paragraph of text for WrapText and WrapTextHard
+func wrapTextLines(text string, width int, breakLong bool) []string {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		if width <= 0 {
+			lines = append(lines, strings.Join(strings.Fields(paragraph), " "))
+			continue
+		}
+		lines = append(lines, wrapWords(paragraph, width, breakLong)...)
+	}
+	return lines
+}
+
+// truncateWithEllipsis truncates a string to the specified visual width, ending it with the given ellipsis
+func truncateWithEllipsis(s string, width int, ellipsis string) string {
 	visualWidth := getVisualWidth(s)
 	if visualWidth <= width {
 		return s
 	}
+
+	ellipsisWidth := getVisualWidth(ellipsis)
+	if width < ellipsisWidth {
+		return truncateToVisualWidth(s, width)
+	}
+
+	truncated := truncateToVisualWidth(s, width-ellipsisWidth)
+	return truncated + ellipsis
+}
+
+// truncateMiddle truncates a string to the specified visual width by replacing its middle with an ellipsis
+func truncateMiddle(s string, width int) string {
+	if getVisualWidth(s) <= width {
+		return s
+	}
 	if width < 3 {
 		return truncateToVisualWidth(s, width)
 	}
-	
-	truncated := truncateToVisualWidth(s, width-3)
-	return truncated + "..."
+
+	keep := width - 1
+	headWidth := (keep + 1) / 2
+	tailWidth := keep - headWidth
+
+	clusters := splitClusters(removeANSIEscapeCodes(s))
+	tailStart := len(clusters)
+	currentWidth := 0
+	for tailStart > 0 {
+		charWidth := clusterWidth(clusters[tailStart-1])
+		if currentWidth+charWidth > tailWidth {
+			break
+		}
+		currentWidth += charWidth
+		tailStart--
+	}
+
+	return truncateToVisualWidth(s, headWidth) + "…" + strings.Join(clusters[tailStart:], "")
 }
 
 // truncateToVisualWidth truncates string to exact visual width
@@ -180,69 +540,79 @@ func truncateToVisualWidth(s string, width int) string {
 		return ""
 	}
 	
-	cleanStr := removeANSIEscapeCodes(s)
 	currentWidth := 0
-	result := ""
-	
-	for len(cleanStr) > 0 {
-		r, size := utf8.DecodeRuneInString(cleanStr)
-		charWidth := 1
-		if r != utf8.RuneError && isWideChar(r) {
-			charWidth = 2
-		}
-		
-		if currentWidth + charWidth > width {
+	var result strings.Builder
+
+	for _, cluster := range splitClusters(removeANSIEscapeCodes(s)) {
+		charWidth := clusterWidth(cluster)
+		if currentWidth+charWidth > width {
 			break
 		}
-		
-		result += string(r)
+
+		result.WriteString(cluster)
 		currentWidth += charWidth
-		cleanStr = cleanStr[size:]
 	}
-	
-	return result
+
+	return result.String()
 }
 
-// getTerminalSize gets terminal size using syscalls for better Windows support
-func getTerminalSize() (width, height int) {
-	if term.IsTerminal(int(os.Stdout.Fd())) {
-		w, h, err := term.GetSize(int(os.Stdout.Fd()))
-		if err == nil {
-			return w, h
+// displayLines returns the number of terminal rows output occupies when wrapped at width
+func displayLines(output string, width int) int {
+	lines := 0
+	for _, line := range strings.Split(output, "\n") {
+		lineWidth := getVisualWidth(line)
+		if width <= 0 || lineWidth == 0 {
+			lines++
+			continue
 		}
+		lines += (lineWidth + width - 1) / width
 	}
+	return lines
+}
 
-	if width, height := getWindowsTerminalSize(); width > 0 && height > 0 {
-		return width, height
+// formatDuration formats a duration for display, e.g. "45s", "1m03s" or "2h05m"
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d.Seconds()))
 	}
-
-	return 80, 24
+	if d < time.Hour {
+		return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+	}
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	return fmt.Sprintf("%dh%02dm", hours, minutes)
 }
 
-// getWindowsTerminalSize gets terminal size on Windows
-func getWindowsTerminalSize() (width, height int) {
-	cmd := exec.Command("powershell", "-Command",
-		"$Host.UI.RawUI.BufferSize.Width; $Host.UI.RawUI.BufferSize.Height")
-
-	output, err := cmd.Output()
-	if err != nil {
-		return 0, 0
+// measureOverflow reports whether rendered output fits within the terminal width
+// and, if not, by how many columns its widest line overflows
+func measureOverflow(rendered string) (bool, int) {
+	maxWidth := 0
+	for _, line := range strings.Split(rendered, "\n") {
+		if width := getVisualWidth(line); width > maxWidth {
+			maxWidth = width
+		}
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	if len(lines) < 2 {
-		return 0, 0
+	overflow := maxWidth - GetTerminal().Width()
+	if overflow > 0 {
+		return false, overflow
 	}
+	return true, 0
+}
 
-	width, err = strconv.Atoi(strings.TrimSpace(lines[0]))
-	if err != nil {
-		return 0, 0
+// getTerminalSize gets the size of the terminal attached to stdout, falling back to
+// the platform console and then to 80x24
+func getTerminalSize() (width, height int) {
+	if term.IsTerminal(int(os.Stdout.Fd())) {
+		w, h, err := term.GetSize(int(os.Stdout.Fd()))
+		if err == nil && w > 0 && h > 0 {
+			return w, h
+		}
 	}
 
-	height, err = strconv.Atoi(strings.TrimSpace(lines[1]))
-	if err != nil {
-		return 0, 0
+	if width, height := getConsoleSize(); width > 0 && height > 0 {
+		return width, height
 	}
 
-	return width, height
+	return 80, 24
 }
@@ -0,0 +1,71 @@
+package clime
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetVisualWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{"ascii", "hello", 5},
+		{"cjk", "日本", 4},
+		{"colored", "\033[31mred\033[0m", 3},
+		{"combining mark", "é", 1},
+		{"emoji", "😀", 2},
+		{"family zwj sequence", "👨‍👩‍👧", 2},
+		{"flag", "🇹🇷", 2},
+		{"two flags", "🇺🇸🇯🇵", 4},
+		{"lone regional indicator", "🇺", 1},
+		{"skin tone", "👍🏽", 2},
+		{"skin tone zwj sequence", "👩🏽‍💻", 2},
+		{"mixed", "ok 👍🏽 🇹🇷!", 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getVisualWidth(tt.text); got != tt.want {
+				t.Errorf("getVisualWidth(%q) = %d, want %d", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResetInteractive(t *testing.T) {
+	defer ResetInteractive()
+
+	var buf strings.Builder
+	ForceInteractive(true)
+	if !isTerminalWriter(&buf) {
+		t.Error("ForceInteractive(true) did not force interactive output")
+	}
+
+	ResetInteractive()
+	if isTerminalWriter(&buf) {
+		t.Error("a buffer is interactive after ResetInteractive")
+	}
+}
+
+func TestWrapTextHard(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  []string
+	}{
+		{"", 10, nil},
+		{"   ", 10, nil},
+		{"one two three", 7, []string{"one two", "three"}},
+		{"a\r\n\nb", 10, []string{"a", "", "b"}},
+		{"abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+	}
+
+	for _, tt := range tests {
+		got := WrapTextHard(tt.text, tt.width)
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") || len(got) != len(tt.want) {
+			t.Errorf("WrapTextHard(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+}
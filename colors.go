@@ -3,9 +3,11 @@ package clime
 import (
 	"fmt"
 	"golang.org/x/term"
+	"math"
 	"os"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 const (
@@ -52,16 +54,25 @@ type ColorFunc func(string) string
 type Color struct {
 	code     string
 	disabled bool
+	r, g, b  int
+	hasRGB   bool
 }
 
-// NewColor creates a new color with the given ANSI code
+// NewColor creates a new color with the given ANSI code. It starts disabled when
+// stdout is not a terminal or the NO_COLOR environment variable is set
 func NewColor(code string) *Color {
 	return &Color{
 		code:     code,
-		disabled: !term.IsTerminal(int(os.Stdout.Fd())),
+		disabled: colorsUnsupported(),
 	}
 }
 
+// colorsUnsupported reports whether new colors should start disabled. It checks
+// stdout with a system call, so code building many colors at once calls it once
+func colorsUnsupported() bool {
+	return !term.IsTerminal(int(os.Stdout.Fd())) || os.Getenv("NO_COLOR") != ""
+}
+
 // Sprint applies the color to a string and returns it
 func (c *Color) Sprint(s string) string {
 	if c.disabled {
@@ -77,17 +88,17 @@ func (c *Color) Sprintf(format string, args ...interface{}) string {
 
 // Print prints the colored string to stdout
 func (c *Color) Print(s string) {
-	fmt.Print(c.Sprint(s))
+	fmt.Fprint(outputWriter(), c.Sprint(s))
 }
 
 // Printf prints the formatted colored string to stdout
 func (c *Color) Printf(format string, args ...interface{}) {
-	fmt.Print(c.Sprintf(format, args...))
+	fmt.Fprint(outputWriter(), c.Sprintf(format, args...))
 }
 
 // Println prints the colored string with a newline
 func (c *Color) Println(s string) {
-	fmt.Println(c.Sprint(s))
+	fmt.Fprintln(outputWriter(), c.Sprint(s))
 }
 
 // Disable disables color output for this color
@@ -145,26 +156,157 @@ var (
 
 // RGB creates a color from RGB values (0-255)
 func RGB(r, g, b int) *Color {
-	code := fmt.Sprintf("\033[38;2;%d;%d;%dm", r, g, b)
-	return NewColor(code)
+	return rgbColor(r, g, b, colorsUnsupported())
+}
+
+// rgbColor creates an RGB color without probing the terminal, for helpers that
+// derive colors from existing ones or create one per character
+func rgbColor(r, g, b int, disabled bool) *Color {
+	return &Color{
+		code:     fmt.Sprintf("\033[38;2;%d;%d;%dm", r, g, b),
+		disabled: disabled,
+		r:        r,
+		g:        g,
+		b:        b,
+		hasRGB:   true,
+	}
+}
+
+// RGBValues returns the RGB components of a color created with RGB or Hex.
+// ok is false for colors built from plain ANSI codes
+func (c *Color) RGBValues() (r, g, b int, ok bool) {
+	return c.r, c.g, c.b, c.hasRGB
+}
+
+// LerpColor interpolates between two colors, with t from 0 (a) to 1 (b).
+// Colors without RGB values snap to whichever end t is closer to
+func LerpColor(a, b *Color, t float64) *Color {
+	if color := lerpRGB(a, b, t); color != nil {
+		return color
+	}
+	if t < 0.5 {
+		return a
+	}
+	return b
+}
+
+// lerpRGB interpolates between two RGB colors, returning nil if either has no RGB values.
+// The result is disabled if either end is
+func lerpRGB(a, b *Color, t float64) *Color {
+	if a == nil || b == nil || !a.hasRGB || !b.hasRGB {
+		return nil
+	}
+
+	if t < 0 {
+		t = 0
+	}
+	if t > 1 {
+		t = 1
+	}
+
+	lerp := func(x, y int) int {
+		return int(math.Round(float64(x) + (float64(y)-float64(x))*t))
+	}
+
+	return rgbColor(lerp(a.r, b.r), lerp(a.g, b.g), lerp(a.b, b.b), a.disabled || b.disabled)
+}
+
+// HSL creates a color from hue (degrees, 0-360), saturation and lightness (0-1)
+func HSL(h, s, l float64) *Color {
+	r, g, b := hslToRGB(h, s, l)
+	return RGB(r, g, b)
+}
+
+// hslToRGB converts hue (degrees), saturation and lightness (0-1) to RGB components
+func hslToRGB(h, s, l float64) (int, int, int) {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	s = math.Max(0, math.Min(1, s))
+	l = math.Max(0, math.Min(1, l))
+
+	chroma := (1 - math.Abs(2*l-1)) * s
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - chroma/2
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = chroma, x, 0
+	case h < 120:
+		r, g, b = x, chroma, 0
+	case h < 180:
+		r, g, b = 0, chroma, x
+	case h < 240:
+		r, g, b = 0, x, chroma
+	case h < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+
+	toByte := func(v float64) int {
+		return int(math.Round((v + m) * 255))
+	}
+
+	return toByte(r), toByte(g), toByte(b)
 }
 
 // Hex creates a color from a hex string (e.g., "#FF0000" or "FF0000")
 func Hex(hex string) *Color {
+	r, g, b, ok := parseHex(hex)
+	if !ok {
+		return NewColor("")
+	}
+	return RGB(r, g, b)
+}
+
+// BgRGB creates a background color from RGB values (0-255)
+func BgRGB(r, g, b int) *Color {
+	return NewColor(fmt.Sprintf("\033[48;2;%d;%d;%dm", r, g, b))
+}
+
+// BgHex creates a background color from a hex string (e.g., "#FF0000" or "FF0000")
+func BgHex(hex string) *Color {
+	r, g, b, ok := parseHex(hex)
+	if !ok {
+		return NewColor("")
+	}
+	return BgRGB(r, g, b)
+}
+
+// parseHex parses a 6-digit hex color string into its RGB components
+func parseHex(hex string) (r, g, b int, ok bool) {
 	hex = strings.TrimPrefix(hex, "#")
 	if len(hex) != 6 {
-		return NewColor("")
+		return 0, 0, 0, false
 	}
 
-	r, err1 := strconv.ParseInt(hex[0:2], 16, 64)
-	g, err2 := strconv.ParseInt(hex[2:4], 16, 64)
-	b, err3 := strconv.ParseInt(hex[4:6], 16, 64)
+	rv, err1 := strconv.ParseInt(hex[0:2], 16, 64)
+	gv, err2 := strconv.ParseInt(hex[2:4], 16, 64)
+	bv, err3 := strconv.ParseInt(hex[4:6], 16, 64)
 
 	if err1 != nil || err2 != nil || err3 != nil {
-		return NewColor("")
+		return 0, 0, 0, false
 	}
 
-	return RGB(int(r), int(g), int(b))
+	return int(rv), int(gv), int(bv), true
+}
+
+// WithBackground returns a copy of the color with the background's code appended,
+// so foreground and background are applied together
+func (c *Color) WithBackground(bg *Color) *Color {
+	combined := *c
+	if bg != nil {
+		combined.code += bg.code
+	}
+	return &combined
+}
+
+// Code returns the raw ANSI escape sequence for the color, for use with Combine
+func (c *Color) Code() string {
+	return c.code
 }
 
 // Combine combines multiple color codes
@@ -199,30 +341,60 @@ func EnableColors() {
 	}
 }
 
-// Gradient creates a gradient effect across text
+// Gradient creates a gradient effect across text. RGB colors are interpolated per
+// character; other colors alternate between start and end
 func Gradient(text string, startColor, endColor *Color) string {
 	if len(text) == 0 {
 		return ""
 	}
 
+	runes := []rune(text)
+	span := float64(len(runes) - 1)
+	if span < 1 {
+		span = 1
+	}
+
 	var result strings.Builder
-	for i, char := range text {
-		if i%2 == 0 {
-			result.WriteString(startColor.Sprint(string(char)))
-		} else {
-			result.WriteString(endColor.Sprint(string(char)))
+	for i, char := range runes {
+		color := lerpRGB(startColor, endColor, float64(i)/span)
+		if color == nil {
+			color = startColor
+			if i%2 != 0 {
+				color = endColor
+			}
 		}
+		result.WriteString(color.Sprint(string(char)))
 	}
 	return result.String()
 }
 
-// Rainbow applies rainbow colors to text
+// Rainbow applies a smooth rainbow to text by rotating the hue evenly across its characters
 func Rainbow(text string) string {
+	count := utf8.RuneCountInString(text)
+	if count == 0 {
+		return ""
+	}
+
+	disabled := colorsUnsupported()
+	var result strings.Builder
+	i := 0
+	for _, char := range text {
+		r, g, b := hslToRGB(360*float64(i)/float64(count), 1, 0.5)
+		result.WriteString(rgbColor(r, g, b, disabled).Sprint(string(char)))
+		i++
+	}
+	return result.String()
+}
+
+// RainbowClassic applies rainbow colors to text by cycling the six basic ANSI colors
+func RainbowClassic(text string) string {
 	colors := []*Color{RedColor, YellowColor, GreenColor, CyanColor, BlueColor, MagentaColor}
 	var result strings.Builder
-	for i, char := range text {
+	i := 0
+	for _, char := range text {
 		color := colors[i%len(colors)]
 		result.WriteString(color.Sprint(string(char)))
+		i++
 	}
 	return result.String()
 }
@@ -0,0 +1,37 @@
+package clime
+
+import "testing"
+
+func TestLerpColorKeepsEndpointState(t *testing.T) {
+	start, end := RGB(255, 0, 0).Enable(), RGB(0, 0, 255).Enable()
+
+	middle := LerpColor(start, end, 0.5)
+	if r, g, b, ok := middle.RGBValues(); !ok || r != 128 || g != 0 || b != 128 {
+		t.Errorf("LerpColor = (%d, %d, %d, %v), want (128, 0, 128, true)", r, g, b, ok)
+	}
+	if middle.IsDisabled() {
+		t.Error("interpolating enabled colors gave a disabled color")
+	}
+
+	if !LerpColor(start, RGB(0, 0, 255).Disable(), 0.5).IsDisabled() {
+		t.Error("interpolating towards a disabled color gave an enabled color")
+	}
+}
+
+func TestHSL(t *testing.T) {
+	tests := []struct {
+		h, s, l float64
+		r, g, b int
+	}{
+		{0, 1, 0.5, 255, 0, 0},
+		{120, 1, 0.5, 0, 255, 0},
+		{240, 1, 0.5, 0, 0, 255},
+		{0, 0, 1, 255, 255, 255},
+	}
+
+	for _, tt := range tests {
+		if r, g, b, _ := HSL(tt.h, tt.s, tt.l).RGBValues(); r != tt.r || g != tt.g || b != tt.b {
+			t.Errorf("HSL(%v, %v, %v) = (%d, %d, %d), want (%d, %d, %d)", tt.h, tt.s, tt.l, r, g, b, tt.r, tt.g, tt.b)
+		}
+	}
+}
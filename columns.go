@@ -0,0 +1,92 @@
+package clime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ColumnOrder sets how Columns fills its grid
+type ColumnOrder int
+
+const (
+	// ColumnMajor fills each column top to bottom before moving right, like ls
+	ColumnMajor ColumnOrder = iota
+	// RowMajor fills each row left to right before moving down
+	RowMajor
+)
+
+// ColumnsOptions configures Columns
+type ColumnsOptions struct {
+	// MaxColumns limits the number of columns; 0 uses GetOptimalColumns for the current breakpoint
+	MaxColumns int
+	// Padding is the space between columns; 0 uses 2
+	Padding int
+	Order   ColumnOrder
+}
+
+// Columns lays out items in aligned columns that fit the terminal width, like ls
+// output. Every column is as wide as the widest item, measured with ANSI codes
+// ignored, and lines carry no trailing spaces
+func Columns(items []string, opts ColumnsOptions) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	padding := opts.Padding
+	if padding <= 0 {
+		padding = 2
+	}
+
+	itemWidth := 0
+	for _, item := range items {
+		itemWidth = max(itemWidth, getVisualWidth(item))
+	}
+
+	rm := GetResponsiveManager()
+	available := rm.currentTerminal().Width() - SmartMargin()*2
+	columns := max((available+padding)/(itemWidth+padding), 1)
+
+	limit := opts.MaxColumns
+	if limit <= 0 {
+		limit = GetOptimalColumns(itemWidth)
+	}
+	columns = min(columns, limit)
+	columns = min(columns, len(items))
+
+	rows := (len(items) + columns - 1) / columns
+	if opts.Order == ColumnMajor {
+		// Drop columns that would be left empty once every column holds rows items
+		columns = (len(items) + rows - 1) / rows
+	}
+
+	var result strings.Builder
+	for row := 0; row < rows; row++ {
+		var line strings.Builder
+		for column := 0; column < columns; column++ {
+			index := row*columns + column
+			if opts.Order == ColumnMajor {
+				index = column*rows + row
+			}
+			if index >= len(items) {
+				continue
+			}
+
+			if column > 0 {
+				line.WriteString(strings.Repeat(" ", padding))
+			}
+			line.WriteString(PadString(items[index], itemWidth))
+		}
+
+		result.WriteString(strings.TrimRight(line.String(), " "))
+		if row < rows-1 {
+			result.WriteString("\n")
+		}
+	}
+
+	return result.String()
+}
+
+// PrintColumns prints items laid out by Columns
+func PrintColumns(items []string, opts ColumnsOptions) {
+	fmt.Fprintln(outputWriter(), Columns(items, opts))
+}
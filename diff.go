@@ -0,0 +1,62 @@
+package clime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Diff returns a line-based diff turning a into b, with removed lines shown in red
+// after "- ", added lines in green after "+ " and unchanged lines after two spaces
+func Diff(a, b string) string {
+	return DiffLines(splitDiffLines(a), splitDiffLines(b))
+}
+
+// DiffLines returns the diff of two slices of lines, formatted as Diff does
+func DiffLines(a, b []string) string {
+	// common[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	common := make([][]int, len(a)+1)
+	for i := range common {
+		common[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	var lines []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, "  "+a[i])
+			i++
+			j++
+		case i < len(a) && (j == len(b) || common[i+1][j] >= common[i][j+1]):
+			lines = append(lines, RedColor.Sprint("- "+a[i]))
+			i++
+		default:
+			lines = append(lines, GreenColor.Sprint("+ "+b[j]))
+			j++
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// PrintDiff prints the diff of a and b
+func PrintDiff(a, b string) {
+	fmt.Fprintln(outputWriter(), Diff(a, b))
+}
+
+// splitDiffLines splits text into lines, ignoring a final newline
+func splitDiffLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -3,6 +3,6 @@ module github.com/alperdrsnn/clime
 go 1.24.4
 
 require (
-	golang.org/x/sys v0.34.0 // indirect
+	golang.org/x/sys v0.34.0
 	golang.org/x/term v0.33.0 // indirect
 )
@@ -0,0 +1,50 @@
+package clime
+
+import (
+	"os"
+	"sync/atomic"
+)
+
+// hyperlinkOverride is 0 to detect support, 1 to always emit OSC 8 links and 2 to always use plain text
+var hyperlinkOverride atomic.Int32
+
+// EnableHyperlinks overrides hyperlink detection: true always emits clickable OSC 8
+// links, false always renders links as "text (url)"
+func EnableHyperlinks(enabled bool) {
+	if enabled {
+		hyperlinkOverride.Store(1)
+	} else {
+		hyperlinkOverride.Store(2)
+	}
+}
+
+// hyperlinksEnabled reports whether links should be written as OSC 8 sequences. Without
+// an override they are used when the output is a terminal that is not marked as dumb
+func hyperlinksEnabled() bool {
+	switch hyperlinkOverride.Load() {
+	case 1:
+		return true
+	case 2:
+		return false
+	}
+	return isTerminalWriter(outputWriter()) && os.Getenv("TERM") != "dumb"
+}
+
+// Link returns text as a clickable hyperlink to url using the OSC 8 escape sequence.
+// When hyperlinks are disabled or unsupported it returns "text (url)" instead. The
+// escape sequence is ignored when measuring width, so links stay aligned in tables and boxes
+func Link(text, url string) string {
+	if url == "" {
+		return text
+	}
+	if !hyperlinksEnabled() {
+		if text == "" || text == url {
+			return url
+		}
+		return text + " (" + url + ")"
+	}
+	if text == "" {
+		text = url
+	}
+	return "\033]8;;" + url + "\033\\" + text + "\033]8;;\033\\"
+}
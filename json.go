@@ -0,0 +1,98 @@
+package clime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SprintJSON returns v as indented JSON with keys, strings, numbers, booleans and
+// null in distinct colors. Without color support it is plain indented JSON.
+// Values that can't be marshalled give an empty string; PrintJSON reports the error
+func SprintJSON(v any) string {
+	output, err := colorizeJSON(v)
+	if err != nil {
+		return ""
+	}
+	return output
+}
+
+// PrintJSON prints v as colorized, indented JSON
+func PrintJSON(v any) error {
+	output, err := colorizeJSON(v)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(outputWriter(), output)
+	return nil
+}
+
+// colorizeJSON marshals v with indentation and colors each token
+func colorizeJSON(v any) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+
+	data := strings.TrimSuffix(buf.String(), "\n")
+
+	var result strings.Builder
+	for i := 0; i < len(data); {
+		switch c := data[i]; {
+		case c == '"':
+			end := jsonStringEnd(data, i)
+			token := data[i:end]
+			if isJSONKey(data, end) {
+				result.WriteString(CyanColor.Sprint(token))
+			} else {
+				result.WriteString(GreenColor.Sprint(token))
+			}
+			i = end
+		case c == '-' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(data) && strings.IndexByte("0123456789+-.eE", data[end]) >= 0 {
+				end++
+			}
+			result.WriteString(YellowColor.Sprint(data[i:end]))
+			i = end
+		case strings.HasPrefix(data[i:], "true"), strings.HasPrefix(data[i:], "false"):
+			end := i + 4
+			if c == 'f' {
+				end++
+			}
+			result.WriteString(MagentaColor.Sprint(data[i:end]))
+			i = end
+		case strings.HasPrefix(data[i:], "null"):
+			result.WriteString(DimColor.Sprint("null"))
+			i += 4
+		default:
+			result.WriteByte(c)
+			i++
+		}
+	}
+
+	return result.String(), nil
+}
+
+// jsonStringEnd returns the index just past the string starting at data[start]
+func jsonStringEnd(data string, start int) int {
+	for i := start + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return len(data)
+}
+
+// isJSONKey reports whether the string ending before data[end] is an object key
+func isJSONKey(data string, end int) bool {
+	rest := strings.TrimLeft(data[end:], " \n")
+	return strings.HasPrefix(rest, ":")
+}
@@ -0,0 +1,59 @@
+package clime
+
+import (
+	"strings"
+	"testing"
+)
+
+const plainJSON = "{\n  \"name\": \"clime\",\n  \"ok\": true,\n  \"stars\": 42,\n  \"tags\": null\n}"
+
+var jsonValue = map[string]any{"name": "clime", "stars": 42, "ok": true, "tags": nil}
+
+// setJSONColors enables or disables the colors SprintJSON uses, restoring them when t ends
+func setJSONColors(t *testing.T, enabled bool) {
+	for _, color := range []*Color{CyanColor, GreenColor, YellowColor, MagentaColor, DimColor} {
+		wasDisabled := color.IsDisabled()
+		if enabled {
+			color.Enable()
+		} else {
+			color.Disable()
+		}
+		t.Cleanup(func() {
+			if wasDisabled {
+				color.Disable()
+			} else {
+				color.Enable()
+			}
+		})
+	}
+}
+
+func TestSprintJSONColorsTokens(t *testing.T) {
+	setJSONColors(t, true)
+	got := SprintJSON(jsonValue)
+
+	for _, token := range []string{
+		CyanColor.Sprint(`"name"`),
+		GreenColor.Sprint(`"clime"`),
+		YellowColor.Sprint("42"),
+		MagentaColor.Sprint("true"),
+		DimColor.Sprint("null"),
+	} {
+		if !strings.HasPrefix(token, "\033[") {
+			t.Fatalf("enabled color rendered %q without an escape code", token)
+		}
+		if !strings.Contains(got, token) {
+			t.Errorf("SprintJSON output %q is missing colored token %q", got, token)
+		}
+	}
+	if text := removeANSIEscapeCodes(got); text != plainJSON {
+		t.Errorf("SprintJSON text =\n%s\nwant\n%s", text, plainJSON)
+	}
+}
+
+func TestSprintJSONWithoutColors(t *testing.T) {
+	setJSONColors(t, false)
+	if got := SprintJSON(jsonValue); got != plainJSON {
+		t.Errorf("SprintJSON with colors disabled =\n%s\nwant\n%s", got, plainJSON)
+	}
+}
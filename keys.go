@@ -0,0 +1,334 @@
+package clime
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// KeyType identifies a key read by ReadKey
+type KeyType int
+
+const (
+	KeyUnknown KeyType = iota
+	KeyChar            // a printable character, held in Key.Rune
+	KeyEnter
+	KeyTab
+	KeyBackspace
+	KeyDelete
+	KeyEsc
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyHome
+	KeyEnd
+	KeyPageUp
+	KeyPageDown
+	KeyCtrlC
+	KeyCtrlD
+	KeyCtrl // any other Ctrl+letter combination, with the lowercase letter in Key.Rune
+)
+
+// Key is a single keypress
+type Key struct {
+	Type KeyType
+	Rune rune
+	// Alt is set when the key was pressed with Alt (sent as an Esc prefix)
+	Alt bool
+}
+
+// String returns a readable name for the key, such as "up", "ctrl+w" or "a"
+func (k Key) String() string {
+	name := ""
+	switch k.Type {
+	case KeyChar:
+		name = string(k.Rune)
+	case KeyEnter:
+		name = "enter"
+	case KeyTab:
+		name = "tab"
+	case KeyBackspace:
+		name = "backspace"
+	case KeyDelete:
+		name = "delete"
+	case KeyEsc:
+		name = "esc"
+	case KeyUp:
+		name = "up"
+	case KeyDown:
+		name = "down"
+	case KeyLeft:
+		name = "left"
+	case KeyRight:
+		name = "right"
+	case KeyHome:
+		name = "home"
+	case KeyEnd:
+		name = "end"
+	case KeyPageUp:
+		name = "pgup"
+	case KeyPageDown:
+		name = "pgdown"
+	case KeyCtrlC:
+		name = "ctrl+c"
+	case KeyCtrlD:
+		name = "ctrl+d"
+	case KeyCtrl:
+		name = "ctrl+" + string(k.Rune)
+	default:
+		name = "unknown"
+	}
+
+	if k.Alt {
+		return "alt+" + name
+	}
+	return name
+}
+
+// ReadKey puts the terminal in raw mode, waits for a single keypress and returns it
+func ReadKey() (Key, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return Key{}, fmt.Errorf("stdin is not a terminal")
+	}
+
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return Key{}, err
+	}
+	defer term.Restore(fd, oldState)
+
+	return readKey(context.Background())
+}
+
+// EscapeTimeout is how long a prompt waits after an Esc byte for the rest of an
+// escape sequence (such as an arrow key) before treating it as the Esc key
+var EscapeTimeout = 100 * time.Millisecond
+
+// pendingKeys holds keys parsed from a chunk of input that have not been returned yet
+var pendingKeys struct {
+	mu   sync.Mutex
+	keys []Key
+}
+
+// readKey returns the next keypress from stdin, which must already be in raw mode,
+// returning ctx.Err() if the context ends first
+func readKey(ctx context.Context) (Key, error) {
+	pendingKeys.mu.Lock()
+	if len(pendingKeys.keys) > 0 {
+		key := pendingKeys.keys[0]
+		pendingKeys.keys = pendingKeys.keys[1:]
+		pendingKeys.mu.Unlock()
+		return key, nil
+	}
+	pendingKeys.mu.Unlock()
+
+	b, err := readKeyBytes(ctx)
+	if err != nil {
+		return Key{}, err
+	}
+
+	// Escape sequences can be split across reads (e.g. over slow connections), so
+	// wait briefly for the rest before treating a trailing Esc as a key of its own
+	for incompleteEscape(b) {
+		waitCtx, cancel := context.WithTimeout(ctx, EscapeTimeout)
+		more, err := readKeyBytes(waitCtx)
+		cancel()
+		if err != nil {
+			break
+		}
+		b = append(b, more...)
+	}
+
+	keys := parseKeys(b)
+	pendingKeys.mu.Lock()
+	pendingKeys.keys = append(pendingKeys.keys, keys[1:]...)
+	pendingKeys.mu.Unlock()
+
+	return keys[0], nil
+}
+
+// hasPendingKeys reports whether keys from the last read (e.g. a paste) are still queued
+func hasPendingKeys() bool {
+	pendingKeys.mu.Lock()
+	defer pendingKeys.mu.Unlock()
+	return len(pendingKeys.keys) > 0
+}
+
+// incompleteEscape reports whether b ends partway through an escape sequence: a
+// trailing Esc, Esc [ with parameters but no final byte, or Esc O without its key
+func incompleteEscape(b []byte) bool {
+	i := bytes.LastIndexByte(b, 27)
+	if i < 0 {
+		return false
+	}
+
+	tail := b[i:]
+	switch {
+	case len(tail) == 1:
+		return true
+	case tail[1] == '[':
+		for _, c := range tail[2:] {
+			if c >= 0x40 && c <= 0x7e {
+				return false
+			}
+		}
+		return true
+	case tail[1] == 'O':
+		return len(tail) == 2
+	}
+	return false
+}
+
+// parseKeys splits raw terminal input into keys
+func parseKeys(b []byte) []Key {
+	var keys []Key
+	for len(b) > 0 {
+		key, n := parseKey(b)
+		keys = append(keys, key)
+		b = b[n:]
+	}
+	return keys
+}
+
+// parseKey decodes the first key in b and returns it with the number of bytes it used
+func parseKey(b []byte) (Key, int) {
+	if b[0] == 27 {
+		return parseEscape(b)
+	}
+
+	r, size := utf8.DecodeRune(b)
+	switch {
+	case r == 13 || r == 10:
+		return Key{Type: KeyEnter}, size
+	case r == 9:
+		return Key{Type: KeyTab}, size
+	case r == 127 || r == 8:
+		return Key{Type: KeyBackspace}, size
+	case r == 3:
+		return Key{Type: KeyCtrlC}, size
+	case r == 4:
+		return Key{Type: KeyCtrlD}, size
+	case r >= 1 && r <= 26:
+		return Key{Type: KeyCtrl, Rune: 'a' + r - 1}, size
+	case r == utf8.RuneError || !unicode.IsPrint(r):
+		return Key{Type: KeyUnknown}, size
+	}
+	return Key{Type: KeyChar, Rune: r}, size
+}
+
+// parseEscape decodes a key starting with Esc: a lone Esc, a CSI (Esc [) or SS3 (Esc O)
+// sequence, or an Alt-modified key
+func parseEscape(b []byte) (Key, int) {
+	if len(b) == 1 || b[1] == 27 {
+		return Key{Type: KeyEsc}, 1
+	}
+
+	switch b[1] {
+	case '[':
+		// Parameters and intermediates run up to a final byte in the range @ to ~
+		for i := 2; i < len(b); i++ {
+			if b[i] >= 0x40 && b[i] <= 0x7e {
+				return Key{Type: csiKeyType(string(b[2:i]), b[i])}, i + 1
+			}
+		}
+		return Key{Type: KeyUnknown}, len(b)
+	case 'O':
+		if len(b) >= 3 {
+			return Key{Type: csiKeyType("", b[2])}, 3
+		}
+	}
+
+	key, n := parseKey(b[1:])
+	key.Alt = true
+	return key, n + 1
+}
+
+// csiKeyType maps the parameters and final byte of a CSI or SS3 sequence to a key.
+// Modifier parameters such as the 5 in Esc[1;5A are ignored
+func csiKeyType(params string, final byte) KeyType {
+	switch final {
+	case 'A':
+		return KeyUp
+	case 'B':
+		return KeyDown
+	case 'C':
+		return KeyRight
+	case 'D':
+		return KeyLeft
+	case 'H':
+		return KeyHome
+	case 'F':
+		return KeyEnd
+	case '~':
+		switch params {
+		case "1", "7":
+			return KeyHome
+		case "4", "8":
+			return KeyEnd
+		case "3":
+			return KeyDelete
+		case "5":
+			return KeyPageUp
+		case "6":
+			return KeyPageDown
+		}
+	}
+	return KeyUnknown
+}
+
+// keyResult is one chunk of raw input read from stdin
+type keyResult struct {
+	b   []byte
+	err error
+}
+
+// stdinKeys reads stdin on behalf of the raw-mode prompts. A read abandoned because
+// its context ended stays in flight and its bytes go to the next caller, so timed
+// reads never drop keystrokes
+var stdinKeys struct {
+	mu       sync.Mutex
+	inFlight bool
+	results  chan keyResult
+}
+
+// readKeyBytes reads the bytes of one keypress (or one pasted chunk) from stdin,
+// returning ctx.Err() if the context ends first
+func readKeyBytes(ctx context.Context) ([]byte, error) {
+	stdinKeys.mu.Lock()
+	if stdinKeys.results == nil {
+		stdinKeys.results = make(chan keyResult, 1)
+	}
+	if !stdinKeys.inFlight {
+		stdinKeys.inFlight = true
+		go func() {
+			b := make([]byte, 64)
+			n, err := os.Stdin.Read(b)
+			if err == nil && n == 0 {
+				err = io.EOF
+			}
+			stdinKeys.results <- keyResult{b[:n], err}
+		}()
+	}
+	results := stdinKeys.results
+	stdinKeys.mu.Unlock()
+
+	select {
+	case r := <-results:
+		stdinKeys.mu.Lock()
+		stdinKeys.inFlight = false
+		stdinKeys.mu.Unlock()
+		return r.b, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,317 @@
+package clime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/term"
+)
+
+// lineEditor holds the state of a single-line raw-mode editor shared by the text prompts
+type lineEditor struct {
+	prompt string
+	buffer []rune
+	cursor int
+	masked bool
+	mask   rune
+
+	history      *[]string
+	historyIndex int
+	draft        string
+
+	validate func(string) error
+
+	// below holds extra lines (e.g. suggestions) drawn under the input, and
+	// cursorRow how many rows the cursor sits below the first row of the prompt
+	below     []string
+	cursorRow int
+	finishing bool
+
+	// ghost is dimmed completion text shown after the input while the cursor is at the end
+	ghost string
+
+	// escCancels makes Esc end the prompt with errEscCancelled
+	escCancels bool
+}
+
+// errEscCancelled is returned by readEditedLine when Esc ends a prompt that allows it
+var errEscCancelled = errors.New("input cancelled with esc")
+
+// newLineEditor creates an editor that redraws prompt in front of the typed text
+func newLineEditor(prompt string) *lineEditor {
+	return &lineEditor{prompt: prompt}
+}
+
+// withHistory lets Up/Down recall entries from history, oldest first
+func (e *lineEditor) withHistory(history *[]string) *lineEditor {
+	e.history = history
+	if history != nil {
+		e.historyIndex = len(*history)
+	}
+	return e
+}
+
+// recall moves through the history by delta entries, keeping the unfinished
+// line as a draft to return to below the newest entry
+func (e *lineEditor) recall(delta int) bool {
+	if e.history == nil || len(*e.history) == 0 {
+		return false
+	}
+
+	entries := *e.history
+	index := e.historyIndex + delta
+	if index < 0 || index > len(entries) {
+		return false
+	}
+
+	if e.historyIndex == len(entries) {
+		e.draft = e.String()
+	}
+	e.historyIndex = index
+
+	if index == len(entries) {
+		e.set(e.draft)
+	} else {
+		e.set(entries[index])
+	}
+	return true
+}
+
+// String returns the current contents of the line
+func (e *lineEditor) String() string {
+	return string(e.buffer)
+}
+
+// set replaces the line and moves the cursor to its end
+func (e *lineEditor) set(s string) {
+	e.buffer = []rune(s)
+	e.cursor = len(e.buffer)
+}
+
+// insert adds text at the cursor, skipping control characters
+func (e *lineEditor) insert(s string) {
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			continue
+		}
+		e.buffer = append(e.buffer, 0)
+		copy(e.buffer[e.cursor+1:], e.buffer[e.cursor:])
+		e.buffer[e.cursor] = r
+		e.cursor++
+	}
+}
+
+// deleteBefore removes n runes before the cursor
+func (e *lineEditor) deleteBefore(n int) {
+	n = min(n, e.cursor)
+	e.buffer = append(e.buffer[:e.cursor-n], e.buffer[e.cursor:]...)
+	e.cursor -= n
+}
+
+// deleteWord removes the word before the cursor along with any spaces after it
+func (e *lineEditor) deleteWord() {
+	start := e.cursor
+	for start > 0 && unicode.IsSpace(e.buffer[start-1]) {
+		start--
+	}
+	for start > 0 && !unicode.IsSpace(e.buffer[start-1]) {
+		start--
+	}
+	e.deleteBefore(e.cursor - start)
+}
+
+// handleKey applies an editing key read in raw mode and reports whether it was recognised.
+// Keys with special meaning to a prompt (Enter, Tab, Up/Down) should be handled before this
+func (e *lineEditor) handleKey(key Key) bool {
+	if key.Alt {
+		return false
+	}
+
+	switch key.Type {
+	case KeyLeft:
+		e.cursor = max(e.cursor-1, 0)
+	case KeyRight:
+		e.cursor = min(e.cursor+1, len(e.buffer))
+	case KeyHome:
+		e.cursor = 0
+	case KeyEnd:
+		e.cursor = len(e.buffer)
+	case KeyDelete:
+		if e.cursor < len(e.buffer) {
+			e.buffer = append(e.buffer[:e.cursor], e.buffer[e.cursor+1:]...)
+		}
+	case KeyUp:
+		return e.recall(-1)
+	case KeyDown:
+		return e.recall(1)
+	case KeyBackspace:
+		e.deleteBefore(1)
+	case KeyChar:
+		e.insert(string(key.Rune))
+	case KeyCtrl:
+		switch key.Rune {
+		case 'a':
+			e.cursor = 0
+		case 'b':
+			e.cursor = max(e.cursor-1, 0)
+		case 'e':
+			e.cursor = len(e.buffer)
+		case 'f':
+			e.cursor = min(e.cursor+1, len(e.buffer))
+		case 'u':
+			e.buffer = e.buffer[:0]
+			e.cursor = 0
+		case 'w':
+			e.deleteWord()
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// display returns the text as it should appear on screen. Masked text shows one
+// mask character per rune, or nothing when no mask character is set
+func (e *lineEditor) display(runes []rune) string {
+	if e.masked {
+		if e.mask == 0 {
+			return ""
+		}
+		return strings.Repeat(string(e.mask), len(runes))
+	}
+	return string(runes)
+}
+
+// validationError runs the live validator on the current line; empty lines are
+// left to the prompt's own required/default handling
+func (e *lineEditor) validationError() error {
+	if e.validate == nil || len(e.buffer) == 0 {
+		return nil
+	}
+	return e.validate(e.String())
+}
+
+// render redraws the prompt, the input and any lines below it from the first row
+// of the prompt, so wrapped input is redrawn correctly, then moves the terminal
+// cursor to the edit position
+func (e *lineEditor) render() {
+	terminal := GetTerminal()
+	terminal.Refresh()
+	width := terminal.Width()
+
+	if e.cursorRow > 0 {
+		fmt.Printf("\033[%dA", e.cursorRow)
+	}
+	fmt.Print("\r\033[J")
+
+	line := e.prompt + e.display(e.buffer)
+	if e.ghost != "" && !e.finishing && e.cursor == len(e.buffer) {
+		line += DimColor.Sprint(e.ghost)
+	}
+	lineWidth := getVisualWidth(line)
+	fmt.Print(line)
+
+	rows := displayLines(line, width)
+	if lineWidth > 0 && lineWidth%width == 0 {
+		// Leave the pending-wrap state so the cursor can be placed on the next row
+		fmt.Print(" \r")
+		rows++
+	}
+	endRow := rows - 1
+
+	below := e.below
+	if !e.finishing {
+		if err := e.validationError(); err != nil {
+			below = append([]string{Error.Sprint(err.Error())}, below...)
+		}
+	}
+	for _, extra := range below {
+		fmt.Print("\r\n" + extra)
+		endRow += displayLines(extra, width)
+	}
+
+	position := getVisualWidth(e.prompt) + getVisualWidth(e.display(e.buffer[:e.cursor]))
+	targetRow := position / width
+	if endRow > targetRow {
+		fmt.Printf("\033[%dA", endRow-targetRow)
+	}
+	fmt.Printf("\033[%dG", position%width+1)
+	e.cursorRow = targetRow
+}
+
+// finish redraws the final input without anything below it and moves to a new line
+func (e *lineEditor) finish() {
+	e.below = nil
+	e.cursor = len(e.buffer)
+	e.finishing = true
+	e.render()
+	fmt.Print("\r\n")
+}
+
+// readEditedLine reads a line with the raw-mode editor, falling back to plain line
+// reading when stdin is not a terminal
+func readEditedLine(ctx context.Context, e *lineEditor) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		fmt.Print(e.prompt)
+		return readLineContext(ctx)
+	}
+
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		fmt.Print(e.prompt)
+		return readLineContext(ctx)
+	}
+	defer term.Restore(fd, oldState)
+
+	e.render()
+
+	dirty := false
+	for {
+		key, err := readKey(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				// Clear anything drawn below the input and leave the cursor on a fresh
+				// line, as the plain reader does, so later output doesn't overwrite it
+				e.finish()
+			} else {
+				fmt.Print("\r\n")
+			}
+			return "", err
+		}
+
+		switch {
+		case key.Type == KeyEnter:
+			if e.validationError() != nil {
+				continue
+			}
+			e.finish()
+			return e.String(), nil
+		case key.Type == KeyCtrlC:
+			e.finish()
+			return "", fmt.Errorf("input cancelled")
+		case key.Type == KeyEsc && e.escCancels:
+			e.finish()
+			return "", errEscCancelled
+		case key.Type == KeyCtrlD && len(e.buffer) == 0:
+			e.finish()
+			return "", io.EOF
+		}
+
+		if e.handleKey(key) {
+			dirty = true
+		}
+		// Pasted text arrives as many keys at once; redraw after the last of them
+		if dirty && !hasPendingKeys() {
+			e.render()
+			dirty = false
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package clime
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"math"
 	"strings"
 	"sync"
@@ -61,6 +63,24 @@ var (
 	}
 )
 
+// Unit controls how progress counts and rates are displayed
+type Unit int
+
+const (
+	UnitCount   Unit = iota // plain item counts
+	UnitBytes               // byte sizes with binary prefixes (KiB, MiB, ...)
+	UnitBytesSI             // byte sizes with decimal prefixes (KB, MB, ...)
+)
+
+// maxRateSamples bounds the number of samples kept for the moving-window rate
+const maxRateSamples = 30
+
+// rateSample records the progress value at a point on the bar's active (unpaused) clock
+type rateSample struct {
+	at    time.Duration
+	value int64
+}
+
 type ProgressBar struct {
 	current          int64
 	total            int64
@@ -78,8 +98,24 @@ type ProgressBar struct {
 	finished         bool
 	ResponsiveConfig *ResponsiveConfig
 	useSmartSizing   bool
+	smartRatio       float64
+	rateWindow       time.Duration
+	samples          []rateSample
+	unit             Unit
+	phase            int
+	lastMilestone    int
+	paused           bool
+	pausedAt         time.Time
+	pausedTotal      time.Duration
+	gradientStart    *Color
+	gradientEnd      *Color
+	template         string
+	fitTerminal      bool
 }
 
+// minFitBarWidth is the narrowest a bar is shrunk to when fitting the terminal
+const minFitBarWidth = 5
+
 // NewProgressBar creates a new progress bar
 func NewProgressBar(total int64) *ProgressBar {
 	smartWidth := SmartWidth(0.6) // Use 60% of smart width
@@ -87,6 +123,7 @@ func NewProgressBar(total int64) *ProgressBar {
 		smartWidth = 20
 	}
 
+	now := time.Now()
 	return &ProgressBar{
 		total:          total,
 		width:          smartWidth,
@@ -95,11 +132,22 @@ func NewProgressBar(total int64) *ProgressBar {
 		bgColor:        DimColor,
 		showPercent:    true,
 		showCount:      true,
-		startTime:      time.Now(),
+		startTime:      now,
 		useSmartSizing: true,
+		smartRatio:     0.6,
+		rateWindow:     5 * time.Second,
+		samples:        []rateSample{{at: 0, value: 0}},
+		lastMilestone:  -1,
+		fitTerminal:    true,
 	}
 }
 
+// NewIndeterminateBar creates a progress bar for work with an unknown total.
+// It animates a bouncing segment on each Print and shows the count and elapsed time
+func NewIndeterminateBar() *ProgressBar {
+	return NewProgressBar(0)
+}
+
 // WithWidth sets the progress bar width
 func (p *ProgressBar) WithWidth(width int) *ProgressBar {
 	p.mu.Lock()
@@ -111,11 +159,22 @@ func (p *ProgressBar) WithWidth(width int) *ProgressBar {
 	return p
 }
 
+// FitTerminal controls whether the bar is shrunk on each render so the whole line,
+// including the label, rate and ETA, fits on one terminal row. It is on by default;
+// a wrapped line cannot be redrawn in place
+func (p *ProgressBar) FitTerminal(enable bool) *ProgressBar {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.fitTerminal = enable
+	return p
+}
+
 // WithSmartWidth enables smart responsive width sizing
 func (p *ProgressBar) WithSmartWidth(percentage float64) *ProgressBar {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	p.width = SmartWidth(percentage)
+	p.smartRatio = percentage
 	p.useSmartSizing = true
 	return p
 }
@@ -145,6 +204,16 @@ func (p *ProgressBar) WithColor(color *Color) *ProgressBar {
 	return p
 }
 
+// WithGradient colors the filled portion with a gradient between two RGB colors
+// (created via RGB or Hex) along the bar's length
+func (p *ProgressBar) WithGradient(start, end *Color) *ProgressBar {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.gradientStart = start
+	p.gradientEnd = end
+	return p
+}
+
 // WithBackgroundColor sets the background color
 func (p *ProgressBar) WithBackgroundColor(color *Color) *ProgressBar {
 	p.mu.Lock()
@@ -193,26 +262,88 @@ func (p *ProgressBar) ShowETA(show bool) *ProgressBar {
 	return p
 }
 
+// WithTemplate sets the layout of the progress line using the tokens {label}, {bar},
+// {percent}, {count}, {rate}, {eta} and {elapsed}, e.g. "{percent} {bar} {eta}".
+// Tokens without data (such as {eta} once finished) render empty, unknown tokens are
+// kept as written and the Show* settings are ignored. An empty template restores
+// the default layout
+func (p *ProgressBar) WithTemplate(tmpl string) *ProgressBar {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.template = tmpl
+	return p
+}
+
+// WithUnit sets the unit used to display the count and rate
+func (p *ProgressBar) WithUnit(unit Unit) *ProgressBar {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.unit = unit
+	return p
+}
+
+// WithRateWindow sets the time window the rate and ETA are averaged over
+func (p *ProgressBar) WithRateWindow(window time.Duration) *ProgressBar {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if window > 0 {
+		p.rateWindow = window
+	}
+	return p
+}
+
+// WithStartTime backdates the bar's clock to start, e.g. when resuming work whose
+// start is known, so the elapsed time, rate and ETA cover the whole run
+func (p *ProgressBar) WithStartTime(start time.Time) *ProgressBar {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.startTime = start
+	p.samples = []rateSample{{at: 0, value: 0}}
+	return p
+}
+
+// Reset sets the bar back to zero and restarts its clock so it can be reused for
+// another phase. The total and display settings are kept. Like the other setters
+// it holds the bar's lock, so it is safe to call while the bar is being rendered
+func (p *ProgressBar) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.current = 0
+	p.finished = false
+	p.startTime = time.Now()
+	p.samples = []rateSample{{at: 0, value: 0}}
+	p.paused = false
+	p.pausedTotal = 0
+	p.phase = 0
+	p.lastMilestone = -1
+}
+
 // Set sets the current progress value
 func (p *ProgressBar) Set(current int64) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	if current > p.total {
+	p.setCurrent(current)
+}
+
+// Add increments the current progress by the given amount. It is safe to call
+// from several goroutines at once
+func (p *ProgressBar) Add(delta int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.setCurrent(p.current + delta)
+}
+
+// setCurrent clamps and stores the current progress; callers hold p.mu
+func (p *ProgressBar) setCurrent(current int64) {
+	if p.total > 0 && current > p.total {
 		current = p.total
 	}
 	if current < 0 {
 		current = 0
 	}
 	p.current = current
-	p.finished = current >= p.total
-}
-
-// Add increments the current progress by the given amount
-func (p *ProgressBar) Add(delta int64) {
-	p.mu.RLock()
-	current := p.current
-	p.mu.RUnlock()
-	p.Set(current + delta)
+	p.finished = p.total > 0 && current >= p.total
+	p.recordSample(current)
 }
 
 // Increment increments the current progress by 1
@@ -229,72 +360,169 @@ func (p *ProgressBar) Render() string {
 		p.calculateResponsiveSize()
 	}
 
-	var progress float64
-	if p.total > 0 {
-		progress = float64(p.current) / float64(p.total)
-	}
-	if progress > 1.0 {
-		progress = 1.0
+	if p.isIndeterminate() {
+		return p.renderIndeterminate()
 	}
 
-	var parts []string
+	progress := p.fraction()
 
-	if p.label != "" {
-		parts = append(parts, p.label)
+	segments := map[string]string{
+		"label":   p.label,
+		"percent": fmt.Sprintf("%3.0f%%", progress*100),
+		"count":   fmt.Sprintf("(%s/%s)", p.formatValue(float64(p.current)), p.formatValue(float64(p.total))),
+		"elapsed": formatDuration(p.activeElapsed()),
+	}
+	if p.activeElapsed() > 0 {
+		segments["rate"] = p.formatRate(p.currentRate()) + "/s"
+	}
+	if !p.finished {
+		if eta := p.calculateETA(); eta > 0 {
+			segments["eta"] = "ETA " + formatDuration(eta)
+		}
 	}
 
-	bar := p.buildBar(progress)
-	parts = append(parts, bar)
+	line := func(bar string) string {
+		segments["bar"] = bar
+		if p.template != "" {
+			return expandProgressTemplate(p.template, segments)
+		}
 
-	if p.showPercent {
-		percentage := fmt.Sprintf("%3.0f%%", progress*100)
-		parts = append(parts, percentage)
+		var parts []string
+		if p.label != "" {
+			parts = append(parts, p.label)
+		}
+		parts = append(parts, segments["bar"])
+		if p.showPercent {
+			parts = append(parts, segments["percent"])
+		}
+		if p.showCount {
+			parts = append(parts, segments["count"])
+		}
+		if p.showRate && segments["rate"] != "" {
+			parts = append(parts, segments["rate"])
+		}
+		if p.showETA && segments["eta"] != "" {
+			parts = append(parts, segments["eta"])
+		}
+		return strings.Join(parts, " ")
 	}
 
-	if p.showCount {
-		count := fmt.Sprintf("(%d/%d)", p.current, p.total)
-		parts = append(parts, count)
-	}
+	return line(p.buildBar(progress, p.fitBarWidth(line)))
+}
 
-	if p.showRate {
-		elapsed := time.Since(p.startTime).Seconds()
-		if elapsed > 0 {
-			rate := float64(p.current) / elapsed
-			rateStr := fmt.Sprintf("%.1f/s", rate)
-			parts = append(parts, rateStr)
-		}
+// fitBarWidth returns the width to draw the bar at. With FitTerminal on, the bar
+// gives up the room taken by the rest of the line, built by line without a bar,
+// so the line stays one column short of the terminal width
+func (p *ProgressBar) fitBarWidth(line func(bar string) string) int {
+	if !p.fitTerminal {
+		return p.width
 	}
 
-	if p.showETA && !p.finished {
-		eta := p.calculateETA()
-		if eta > 0 {
-			etaStr := p.formatDuration(eta)
-			parts = append(parts, "ETA "+etaStr)
+	terminal := GetTerminal()
+	terminal.Refresh()
+	room := terminal.Width() - 1 - getVisualWidth(line("")) - getVisualWidth(p.style.LeftBorder+p.style.RightBorder)
+	return max(min(p.width, room), minFitBarWidth)
+}
+
+// expandProgressTemplate replaces each {name} token in tmpl with its segment.
+// Known tokens without data become empty; unknown tokens are kept as written
+func expandProgressTemplate(tmpl string, segments map[string]string) string {
+	var result strings.Builder
+	for {
+		start := strings.IndexByte(tmpl, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(tmpl[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+
+		result.WriteString(tmpl[:start])
+		name := tmpl[start+1 : end]
+		if _, known := progressTemplateTokens[name]; known {
+			result.WriteString(segments[name])
+		} else {
+			result.WriteString(tmpl[start : end+1])
 		}
+		tmpl = tmpl[end+1:]
 	}
+	result.WriteString(tmpl)
+	return result.String()
+}
 
-	return strings.Join(parts, " ")
+// progressTemplateTokens lists the token names WithTemplate understands
+var progressTemplateTokens = map[string]struct{}{
+	"label": {}, "bar": {}, "percent": {}, "count": {}, "rate": {}, "eta": {}, "elapsed": {},
 }
 
 // Print renders and prints the progress bar
 func (p *ProgressBar) Print() {
+	p.advancePhase()
+	p.RenderTo(outputWriter())
+}
+
+// RenderTo renders the progress bar to w. On a terminal the line is redrawn in place;
+// otherwise a new line is written only at every 10% milestone, or every
+// indeterminateLogInterval when the total is unknown, so logs are not flooded
+func (p *ProgressBar) RenderTo(w io.Writer) {
 	rendered := p.Render()
-	if p.IsFinished() {
-		fmt.Print("\r" + rendered + "\n")
-	} else {
-		fmt.Print("\r" + rendered)
+	interactive := isTerminalWriter(w)
+
+	p.mu.Lock()
+	milestone := p.milestone()
+	if p.lastMilestone == finishedMilestone || (!interactive && milestone <= p.lastMilestone) {
+		p.mu.Unlock()
+		return
+	}
+	p.lastMilestone = milestone
+	finished := p.finished
+	p.mu.Unlock()
+
+	switch {
+	case !interactive:
+		fmt.Fprintln(w, rendered)
+	case finished:
+		fmt.Fprint(w, "\r"+rendered+"\n")
+	default:
+		fmt.Fprint(w, "\r"+rendered)
+	}
+}
+
+// indeterminateLogInterval is how often a bar with an unknown total writes a line
+// when output is not a terminal
+const indeterminateLogInterval = 10 * time.Second
+
+// finishedMilestone is the milestone of a finished bar
+const finishedMilestone = math.MaxInt32
+
+// milestone returns the completed tenth of the progress, or for an unknown total the
+// number of indeterminateLogIntervals elapsed, and finishedMilestone once finished
+func (p *ProgressBar) milestone() int {
+	if p.finished {
+		return finishedMilestone
 	}
+	if p.isIndeterminate() {
+		return int(p.activeElapsed() / indeterminateLogInterval)
+	}
+	return int(p.current * 10 / p.total)
 }
 
 // Println renders and prints the progress bar with a newline
 func (p *ProgressBar) Println() {
-	fmt.Println(p.Render())
+	fmt.Fprintln(outputWriter(), p.Render())
 }
 
 // Finish completes the progress bar
 func (p *ProgressBar) Finish() {
-	p.Set(p.total)
-	fmt.Print("\r" + p.Render() + "\n")
+	p.mu.Lock()
+	if p.total > 0 {
+		p.current = p.total
+	}
+	p.finished = true
+	p.mu.Unlock()
+	p.RenderTo(outputWriter())
 }
 
 // IsFinished returns true if the progress bar is finished
@@ -318,21 +546,142 @@ func (p *ProgressBar) GetTotal() int64 {
 	return p.total
 }
 
+// ProgressStats is a snapshot of a progress bar's values, for drawing it in a custom layout
+type ProgressStats struct {
+	Current int64
+	Total   int64
+	// Percent is the completed share from 0 to 100, or 0 when the total is unknown
+	Percent float64
+	// Rate is the progress per second over the rate window
+	Rate float64
+	// ETA is the estimated time left, or 0 when it can't be estimated or the bar is finished
+	ETA     time.Duration
+	Elapsed time.Duration
+}
+
+// Stats returns the bar's current values and the rate, ETA and elapsed time
+// the rendered bar would show
+func (p *ProgressBar) Stats() ProgressStats {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	stats := ProgressStats{
+		Current: p.current,
+		Total:   p.total,
+		Percent: p.fraction() * 100,
+		Rate:    p.currentRate(),
+		Elapsed: p.activeElapsed(),
+	}
+	if !p.finished && !p.isIndeterminate() {
+		stats.ETA = p.calculateETA()
+	}
+	return stats
+}
+
+// fraction returns the completed share of the total from 0 to 1
+func (p *ProgressBar) fraction() float64 {
+	if p.total <= 0 {
+		return 0
+	}
+	return math.Min(float64(p.current)/float64(p.total), 1)
+}
+
 // SetTotal sets a new total value
 func (p *ProgressBar) SetTotal(total int64) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	p.total = total
-	if p.current > p.total {
+	if p.total > 0 && p.current > p.total {
 		p.current = p.total
 	}
-	p.finished = p.current >= p.total
+	p.finished = p.total > 0 && p.current >= p.total
 }
 
-// buildBar builds the visual progress bar
-func (p *ProgressBar) buildBar(progress float64) string {
-	filledLength := int(math.Round(float64(p.width) * progress))
-	emptyLength := p.width - filledLength
+// isIndeterminate reports whether the bar has no known total
+func (p *ProgressBar) isIndeterminate() bool {
+	return p.total <= 0
+}
+
+// advancePhase moves the indeterminate animation forward by one step
+func (p *ProgressBar) advancePhase() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.phase++
+}
+
+// renderIndeterminate renders the bar for an unknown total with the count and elapsed time
+func (p *ProgressBar) renderIndeterminate() string {
+	count := fmt.Sprintf("(%s)", p.formatValue(float64(p.current)))
+	elapsed := formatDuration(p.activeElapsed())
+
+	line := func(bar string) string {
+		if p.template != "" {
+			return expandProgressTemplate(p.template, map[string]string{
+				"label":   p.label,
+				"bar":     bar,
+				"count":   count,
+				"elapsed": elapsed,
+			})
+		}
+
+		var parts []string
+
+		if p.label != "" {
+			parts = append(parts, p.label)
+		}
+
+		parts = append(parts, bar)
+
+		if p.showCount {
+			parts = append(parts, count)
+		}
+
+		parts = append(parts, elapsed)
+
+		return strings.Join(parts, " ")
+	}
+
+	return line(p.buildIndeterminateBar(p.fitBarWidth(line)))
+}
+
+// buildIndeterminateBar builds a bar with a filled segment bouncing between its ends
+func (p *ProgressBar) buildIndeterminateBar(width int) string {
+	segment := width / 5
+	if segment < 1 {
+		segment = 1
+	}
+	if segment > width {
+		segment = width
+	}
+
+	travel := width - segment
+	position := 0
+	if travel > 0 {
+		position = p.phase % (2 * travel)
+		if position > travel {
+			position = 2*travel - position
+		}
+	}
+
+	filled := strings.Repeat(p.style.Filled, segment)
+	before := strings.Repeat(p.style.Empty, position)
+	after := strings.Repeat(p.style.Empty, travel-position)
+
+	if p.color != nil {
+		filled = p.color.Sprint(filled)
+	}
+	if p.bgColor != nil {
+		before = p.bgColor.Sprint(before)
+		after = p.bgColor.Sprint(after)
+	}
+
+	return p.style.LeftBorder + before + filled + after + p.style.RightBorder
+}
+
+// buildBar builds the visual progress bar width cells wide
+func (p *ProgressBar) buildBar(progress float64, width int) string {
+	filledLength := int(math.Round(float64(width) * progress))
+	emptyLength := width - filledLength
 
 	var filled string
 	if filledLength > 0 {
@@ -346,7 +695,9 @@ func (p *ProgressBar) buildBar(progress float64) string {
 
 	empty := strings.Repeat(p.style.Empty, emptyLength)
 
-	if p.color != nil {
+	if gradient := p.buildGradient(filled, width); gradient != "" {
+		filled = gradient
+	} else if p.color != nil {
 		filled = p.color.Sprint(filled)
 	}
 	if p.bgColor != nil {
@@ -356,15 +707,135 @@ func (p *ProgressBar) buildBar(progress float64) string {
 	return p.style.LeftBorder + filled + empty + p.style.RightBorder
 }
 
+// buildGradient colors each filled cell by its position along the full bar width.
+// It returns an empty string when no usable RGB gradient is configured
+func (p *ProgressBar) buildGradient(filled string, width int) string {
+	if filled == "" || lerpRGB(p.gradientStart, p.gradientEnd, 0) == nil {
+		return ""
+	}
+
+	span := float64(width - 1)
+	if span < 1 {
+		span = 1
+	}
+
+	var result strings.Builder
+	for i, cell := range []rune(filled) {
+		result.WriteString(lerpRGB(p.gradientStart, p.gradientEnd, float64(i)/span).Sprint(string(cell)))
+	}
+	return result.String()
+}
+
+// formatValue formats a count according to the progress bar unit
+func (p *ProgressBar) formatValue(value float64) string {
+	switch p.unit {
+	case UnitBytes:
+		return formatBytes(value, false)
+	case UnitBytesSI:
+		return formatBytes(value, true)
+	}
+	return fmt.Sprintf("%d", int64(value))
+}
+
+// formatRate formats a per-second rate according to the progress bar unit
+func (p *ProgressBar) formatRate(rate float64) string {
+	if p.unit == UnitCount {
+		return fmt.Sprintf("%.1f", rate)
+	}
+	return p.formatValue(rate)
+}
+
+// formatBytes formats a byte size using binary (KiB) or SI (KB) prefixes
+func formatBytes(value float64, si bool) string {
+	base := 1024.0
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"}
+	if si {
+		base = 1000.0
+		units = []string{"B", "KB", "MB", "GB", "TB", "PB"}
+	}
+
+	if value < base {
+		return fmt.Sprintf("%.0f B", value)
+	}
+
+	exp := 0
+	for value >= base && exp < len(units)-1 {
+		value /= base
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %s", value, units[exp])
+}
+
+// Pause stops the bar's clock so time spent stalled is excluded from the rate and ETA
+func (p *ProgressBar) Pause() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.paused {
+		p.paused = true
+		p.pausedAt = time.Now()
+	}
+}
+
+// Resume restarts the bar's clock after Pause
+func (p *ProgressBar) Resume() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.paused {
+		p.pausedTotal += time.Since(p.pausedAt)
+		p.paused = false
+	}
+}
+
+// activeElapsed returns the time since the bar started, excluding paused time
+func (p *ProgressBar) activeElapsed() time.Duration {
+	elapsed := time.Since(p.startTime) - p.pausedTotal
+	if p.paused {
+		elapsed -= time.Since(p.pausedAt)
+	}
+	return elapsed
+}
+
+// recordSample stores a progress sample, dropping those that fall outside the rate window
+func (p *ProgressBar) recordSample(value int64) {
+	now := p.activeElapsed()
+	p.samples = append(p.samples, rateSample{at: now, value: value})
+
+	cutoff := now - p.rateWindow
+	drop := 0
+	// Keep the newest sample older than the window as the rate's starting point
+	for drop < len(p.samples)-2 && p.samples[drop+1].at < cutoff {
+		drop++
+	}
+	if len(p.samples)-drop > maxRateSamples {
+		drop = len(p.samples) - maxRateSamples
+	}
+	p.samples = p.samples[drop:]
+}
+
+// currentRate returns the progress per second over the recent rate window
+func (p *ProgressBar) currentRate() float64 {
+	if len(p.samples) == 0 {
+		return 0
+	}
+
+	oldest := p.samples[0]
+	elapsed := (p.activeElapsed() - oldest.at).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return float64(p.current-oldest.value) / elapsed
+}
+
 // calculateETA calculates estimated time of arrival
 func (p *ProgressBar) calculateETA() time.Duration {
 	if p.current == 0 {
 		return 0
 	}
 
-	elapsed := time.Since(p.startTime)
 	remaining := p.total - p.current
-	rate := float64(p.current) / elapsed.Seconds()
+	rate := p.currentRate()
 
 	if rate <= 0 {
 		return 0
@@ -374,23 +845,11 @@ func (p *ProgressBar) calculateETA() time.Duration {
 	return eta
 }
 
-// formatDuration formats a duration for display
-func (p *ProgressBar) formatDuration(d time.Duration) string {
-	if d < time.Minute {
-		return fmt.Sprintf("%ds", int(d.Seconds()))
-	}
-	if d < time.Hour {
-		return fmt.Sprintf("%dm%ds", int(d.Minutes()), int(d.Seconds())%60)
-	}
-	hours := int(d.Hours())
-	minutes := int(d.Minutes()) % 60
-	return fmt.Sprintf("%dh%dm", hours, minutes)
-}
-
 // MultiBar represents multiple progress bars
 type MultiBar struct {
-	bars []*ProgressBar
-	mu   sync.RWMutex
+	bars      []*ProgressBar
+	mu        sync.RWMutex
+	lastLines int
 }
 
 // NewMultiBar creates a new multi-progress bar
@@ -422,19 +881,39 @@ func (m *MultiBar) Render() string {
 
 // Print renders and prints all progress bars
 func (m *MultiBar) Print() {
+	m.mu.RLock()
+	for _, bar := range m.bars {
+		bar.advancePhase()
+	}
+	m.mu.RUnlock()
+
+	if !isTerminalWriter(outputWriter()) {
+		// Without a terminal each bar writes its own line at progress milestones
+		m.mu.RLock()
+		for _, bar := range m.bars {
+			bar.RenderTo(outputWriter())
+		}
+		m.mu.RUnlock()
+		return
+	}
+
 	output := m.Render()
-	lines := strings.Count(output, "\n") + 1
 
-	if lines > 1 {
-		MoveCursorUp(lines - 1)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Bars wider than the terminal wrap, so count the rows actually drawn last time
+	if m.lastLines > 1 {
+		MoveCursorUp(m.lastLines - 1)
 	}
 
-	fmt.Print("\r" + output)
+	fmt.Fprint(outputWriter(), "\r\033[J"+output)
+	m.lastLines = displayLines(output, GetTerminal().Width())
 }
 
 // Println renders and prints all progress bars with a final newline
 func (m *MultiBar) Println() {
-	fmt.Println(m.Render())
+	fmt.Fprintln(outputWriter(), m.Render())
 }
 
 // ShowProgress shows a progress bar for a slice operation
@@ -473,6 +952,81 @@ func ShowProgressWithStyle[T any](items []T, label string, style ProgressBarStyl
 	return nil
 }
 
+// parallelRefreshInterval is how often ShowProgressParallel redraws its bar
+const parallelRefreshInterval = 100 * time.Millisecond
+
+// ShowProgressParallel shows a progress bar while fn runs on items with the given
+// number of workers. Only one goroutine draws the bar, so worker output never
+// interleaves with it. After the first error no further items are started; the
+// items already running are waited for and the first error is returned
+func ShowProgressParallel[T any](items []T, label string, workers int, fn func(T) error) error {
+	if workers < 1 {
+		workers = 1
+	}
+
+	bar := NewProgressBar(int64(len(items))).WithLabel(label)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var firstErr error
+	var errOnce sync.Once
+	var wg sync.WaitGroup
+	jobs := make(chan T)
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range jobs {
+				if err := fn(item); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				bar.Increment()
+			}
+		}()
+	}
+
+	rendered := make(chan struct{})
+	go func() {
+		defer close(rendered)
+		ticker := time.NewTicker(parallelRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				bar.Print()
+			}
+		}
+	}()
+
+dispatch:
+	for _, item := range items {
+		select {
+		case jobs <- item:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	cancel()
+	<-rendered
+
+	if firstErr != nil {
+		bar.Println()
+		return firstErr
+	}
+
+	bar.Finish()
+	return nil
+}
+
 // calculateResponsiveSize calculates responsive progress bar size
 func (p *ProgressBar) calculateResponsiveSize() {
 	if p.ResponsiveConfig != nil {
@@ -495,7 +1049,7 @@ func (p *ProgressBar) calculateResponsiveSize() {
 	if p.useSmartSizing {
 		rm := GetResponsiveManager()
 		rm.RefreshBreakpoint()
-		p.width = SmartWidth(0.6)
+		p.width = SmartWidth(p.smartRatio)
 		
 		switch rm.GetCurrentBreakpoint() {
 		case BreakpointXS:
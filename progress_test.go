@@ -0,0 +1,105 @@
+package clime
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestProgressBarAddConcurrent(t *testing.T) {
+	const workers, increments = 8, 10000
+
+	bar := NewProgressBar(workers * increments)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range increments {
+				bar.Increment()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := bar.Stats().Current; got != workers*increments {
+		t.Errorf("current = %d, want %d", got, workers*increments)
+	}
+}
+
+func TestDisplayLines(t *testing.T) {
+	tests := []struct {
+		output string
+		width  int
+		want   int
+	}{
+		{"short", 20, 1},
+		{strings.Repeat("x", 20), 20, 1},
+		{strings.Repeat("x", 21), 20, 2},
+		{strings.Repeat("x", 45) + "\n" + "y", 20, 4},
+		{"\n", 20, 2},
+		{"\033[32m" + strings.Repeat("x", 30) + "\033[0m", 20, 2},
+	}
+
+	for _, tt := range tests {
+		if got := displayLines(tt.output, tt.width); got != tt.want {
+			t.Errorf("displayLines(%q, %d) = %d, want %d", tt.output, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestMultiBarRedrawsWrappedRows(t *testing.T) {
+	var out strings.Builder
+	SetOutput(&out)
+	ForceInteractive(true)
+	terminal := GetTerminal()
+	terminal.mu.Lock()
+	terminal.width = 40
+	terminal.mu.Unlock()
+	defer func() {
+		SetOutput(nil)
+		ResetInteractive()
+		terminal.Refresh()
+	}()
+
+	multi := NewMultiBar()
+	for _, label := range []string{"Downloading packages", "Extracting archives"} {
+		bar := NewProgressBar(100).WithLabel(label).WithWidth(40).FitTerminal(false)
+		bar.Set(50)
+		multi.AddBar(bar)
+	}
+
+	// Each bar line is between 41 and 80 columns, so it wraps onto two rows
+	for _, line := range strings.Split(multi.Render(), "\n") {
+		if w := getVisualWidth(line); w <= 40 || w > 80 {
+			t.Fatalf("line %q is %d columns; the test expects each bar to wrap once", line, w)
+		}
+	}
+
+	multi.Print()
+	out.Reset()
+	multi.Print()
+
+	// The second frame goes back to the first of the 4 rows drawn, not the first of 2 lines
+	if frame := out.String(); !strings.HasPrefix(frame, "\033[3A") {
+		t.Errorf("second frame starts with %q, want the cursor moved up 3 rows", frame[:min(len(frame), 8)])
+	}
+}
+
+func TestIndeterminateBarLogsPeriodically(t *testing.T) {
+	var out strings.Builder
+	bar := NewIndeterminateBar().WithLabel("Scanning")
+
+	bar.RenderTo(&out)
+	bar.RenderTo(&out)
+	if got := strings.Count(out.String(), "\n"); got != 1 {
+		t.Fatalf("wrote %d lines within the first interval, want 1", got)
+	}
+
+	bar.WithStartTime(time.Now().Add(-indeterminateLogInterval))
+	bar.RenderTo(&out)
+	if got := strings.Count(out.String(), "\n"); got != 2 {
+		t.Errorf("wrote %d lines after an interval passed, want 2", got)
+	}
+}
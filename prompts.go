@@ -2,11 +2,15 @@ package clime
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 
 	"golang.org/x/term"
@@ -20,11 +24,25 @@ type InputConfig struct {
 	Mask        bool
 	Validate    func(string) error
 	Transform   func(string) string
+	// MaskChar is echoed for each typed character when Mask is set; 0 echoes nothing
+	MaskChar rune
+	// LiveValidate runs Validate on every keystroke, showing the error under the
+	// prompt and refusing Enter until the input is valid
+	LiveValidate bool
+	// DefaultOnTimeout makes InputContext return Default instead of an error
+	// when the context ends before the user answers
+	DefaultOnTimeout bool
+	// History, when set, is recalled with Up/Down and each accepted answer is
+	// appended to it. The caller owns the slice and can reuse it across prompts
+	History *[]string
 }
 
 type ConfirmConfig struct {
 	Label   string
 	Default bool
+	// DefaultOnTimeout makes ConfirmContext return Default instead of an error
+	// when the context ends before the user answers
+	DefaultOnTimeout bool
 }
 
 type SelectConfig struct {
@@ -32,23 +50,42 @@ type SelectConfig struct {
 	Options  []string
 	Default  int
 	Multiple bool
+	// PageSize limits how many options are shown at once; 0 fits the terminal height
+	PageSize int
+	// Min and Max bound how many options MultiSelect accepts; 0 means no limit
+	Min int
+	Max int
+	// SelectOnNumber makes pressing 1-9 in Select choose that option at once instead of only moving to it
+	SelectOnNumber bool
 }
 
 // Input shows a text input prompt
 func Input(config InputConfig) (string, error) {
-	prompt := buildInputPrompt(config)
-	fmt.Print(prompt)
-
-	var input string
-	var err error
+	return InputContext(context.Background(), config)
+}
 
-	if config.Mask {
-		input, err = readPassword()
-	} else {
-		input, err = readLine()
+// InputContext shows a text input prompt that gives up when ctx is cancelled or times out
+func InputContext(ctx context.Context, config InputConfig) (string, error) {
+	editor := newLineEditor(buildInputPrompt(config))
+	editor.masked = config.Mask
+	editor.mask = config.MaskChar
+	if !config.Mask {
+		editor.withHistory(config.History)
+	}
+	if config.LiveValidate && config.Validate != nil {
+		editor.validate = func(input string) error {
+			if config.Transform != nil {
+				input = config.Transform(input)
+			}
+			return config.Validate(input)
+		}
 	}
 
+	input, err := readEditedLine(ctx, editor)
 	if err != nil {
+		if ctx.Err() != nil && config.DefaultOnTimeout && config.Default != "" {
+			return config.Default, nil
+		}
 		return "", err
 	}
 
@@ -58,9 +95,11 @@ func Input(config InputConfig) (string, error) {
 
 	if config.Required && strings.TrimSpace(input) == "" {
 		Error.Println("This field is required")
-		return Input(config)
+		return InputContext(ctx, config)
 	}
 
+	entry := input
+
 	if config.Transform != nil {
 		input = config.Transform(input)
 	}
@@ -68,25 +107,49 @@ func Input(config InputConfig) (string, error) {
 	if config.Validate != nil {
 		if err := config.Validate(input); err != nil {
 			Error.Printf("Validation failed: %v\n", err)
-			return Input(config) // Retry
+			return InputContext(ctx, config) // Retry
 		}
 	}
 
+	if config.History != nil && !config.Mask {
+		addHistory(config.History, entry)
+	}
+
 	return input, nil
 }
 
+// addHistory appends entry to history unless it is blank or repeats the latest entry
+func addHistory(history *[]string, entry string) {
+	if strings.TrimSpace(entry) == "" {
+		return
+	}
+	if n := len(*history); n > 0 && (*history)[n-1] == entry {
+		return
+	}
+	*history = append(*history, entry)
+}
+
 // Confirm shows a yes/no confirmation prompt
 func Confirm(config ConfirmConfig) (bool, error) {
+	return ConfirmContext(context.Background(), config)
+}
+
+// ConfirmContext shows a yes/no confirmation prompt that gives up when ctx is cancelled or times out
+func ConfirmContext(ctx context.Context, config ConfirmConfig) (bool, error) {
 	defaultText := "y/N"
 	if config.Default {
 		defaultText = "Y/n"
 	}
 
-	prompt := fmt.Sprintf("%s (%s): ", config.Label, defaultText)
-	fmt.Print(Info.Sprint("? ") + prompt)
+	// The raw-mode editor and, without a terminal, the line reader both hand a read
+	// abandoned by a timed-out prompt to the next one, so no input is swallowed
+	editor := newLineEditor(Info.Sprint("? ") + fmt.Sprintf("%s (%s): ", config.Label, defaultText))
 
-	input, err := readLine()
+	input, err := readEditedLine(ctx, editor)
 	if err != nil {
+		if ctx.Err() != nil && config.DefaultOnTimeout {
+			return config.Default, nil
+		}
 		return false, err
 	}
 
@@ -103,7 +166,72 @@ func Confirm(config ConfirmConfig) (bool, error) {
 		return false, nil
 	default:
 		Warning.Println("Please answer yes or no")
-		return Confirm(config)
+		return ConfirmContext(ctx, config)
+	}
+}
+
+// ConfirmResult is the answer to a ConfirmCancel prompt
+type ConfirmResult int
+
+const (
+	ConfirmResultNo ConfirmResult = iota
+	ConfirmResultYes
+	ConfirmResultCancel
+)
+
+// String returns "yes", "no" or "cancel"
+func (r ConfirmResult) String() string {
+	switch r {
+	case ConfirmResultYes:
+		return "yes"
+	case ConfirmResultCancel:
+		return "cancel"
+	}
+	return "no"
+}
+
+// ConfirmCancel shows a yes/no/cancel prompt. c, cancel or Esc cancel
+func ConfirmCancel(config ConfirmConfig) (ConfirmResult, error) {
+	return ConfirmCancelContext(context.Background(), config)
+}
+
+// ConfirmCancelContext shows a yes/no/cancel prompt that gives up when ctx is cancelled or times out
+func ConfirmCancelContext(ctx context.Context, config ConfirmConfig) (ConfirmResult, error) {
+	defaultResult := ConfirmResultNo
+	defaultText := "y/N/c"
+	if config.Default {
+		defaultResult = ConfirmResultYes
+		defaultText = "Y/n/c"
+	}
+
+	editor := newLineEditor(Info.Sprint("? ") + fmt.Sprintf("%s (%s): ", config.Label, defaultText))
+	editor.escCancels = true
+
+	input, err := readEditedLine(ctx, editor)
+	if err == errEscCancelled {
+		return ConfirmResultCancel, nil
+	}
+	if err != nil {
+		if ctx.Err() != nil && config.DefaultOnTimeout {
+			return defaultResult, nil
+		}
+		return ConfirmResultCancel, err
+	}
+
+	input = strings.TrimSpace(strings.ToLower(input))
+
+	switch input {
+	case "":
+		return defaultResult, nil
+	case "y", "yes", "true", "1":
+		return ConfirmResultYes, nil
+	case "n", "no", "false", "0":
+		return ConfirmResultNo, nil
+	case "c", "cancel", "q", "quit":
+		return ConfirmResultCancel, nil
+	default:
+		Warning.Println("Please answer yes, no or cancel")
+		return ConfirmCancelContext(ctx, config)
 	}
 }
 
@@ -145,15 +273,19 @@ func Select(config SelectConfig) (int, error) {
 }
 
 func selectInteractive(config SelectConfig) (int, error) {
-	currentSelection := config.Default
-	if currentSelection >= len(config.Options) {
-		currentSelection = 0
+	filter := ""
+	visible := filterSelectOptions(config.Options, filter)
+
+	cursor := config.Default
+	if cursor >= len(config.Options) || cursor < 0 {
+		cursor = 0
 	}
 
 	HideCursor()
 	defer ShowCursor()
 
-	displaySelectOptions(config, currentSelection)
+	offset := scrollSelectWindow(cursor, 0, selectPageSize(config, len(visible)))
+	lines := displaySelectOptions(config, visible, cursor, offset, filter)
 
 	oldState, err := term.MakeRaw(int(os.Stdin.Fd()))
 	if err != nil {
@@ -161,51 +293,124 @@ func selectInteractive(config SelectConfig) (int, error) {
 	}
 	defer term.Restore(int(os.Stdin.Fd()), oldState)
 
+	accept := func(selection int) (int, error) {
+		clearSelectDisplay(lines)
+		fmt.Printf("%s %s\n", Info.Sprint("?"), config.Label)
+		fmt.Printf("  %s %s\n", Success.Sprint("→"), config.Options[selection])
+		return selection, nil
+	}
+
 	for {
-		b := make([]byte, 4)
-		n, err := os.Stdin.Read(b)
+		key, err := readKey(context.Background())
 		if err != nil {
 			return 0, err
 		}
 
-		if n == 1 {
-			switch b[0] {
-			case 13:
-				clearSelectDisplay(len(config.Options) + 2)
-				fmt.Printf("%s %s\n", Info.Sprint("?"), config.Label)
-				fmt.Printf("  %s %s\n", Success.Sprint("→"), config.Options[currentSelection])
-				return currentSelection, nil
-				
-			case 27:
-				if n == 1 {
-					clearSelectDisplay(len(config.Options) + 2)
-					return 0, fmt.Errorf("selection cancelled")
-				}
-				
-			case 'q', 'Q':
-				clearSelectDisplay(len(config.Options) + 2)
-				return 0, fmt.Errorf("selection cancelled")
+		switch key.Type {
+		case KeyEnter:
+			if len(visible) == 0 {
+				continue
 			}
-		} else if n >= 3 && b[0] == 27 && b[1] == 91 {
-			switch b[2] {
-			case 65:
-				if currentSelection > 0 {
-					currentSelection--
-				} else {
-					currentSelection = len(config.Options) - 1
+			return accept(visible[cursor])
+
+		case KeyCtrlC, KeyEsc:
+			clearSelectDisplay(lines)
+			return 0, fmt.Errorf("selection cancelled")
+
+		case KeyBackspace:
+			if filter == "" {
+				continue
+			}
+			runes := []rune(filter)
+			filter = string(runes[:len(runes)-1])
+			visible = filterSelectOptions(config.Options, filter)
+			cursor, offset = 0, 0
+
+		case KeyChar:
+			if key.Alt {
+				continue
+			}
+			// Without a filter, 1-9 jump to that option; otherwise digits filter like any character
+			if digit := int(key.Rune - '0'); filter == "" && digit >= 1 && digit <= 9 {
+				if digit > len(visible) {
+					continue
 				}
-				refreshSelectDisplay(config, currentSelection)
-				
-			case 66:
-				if currentSelection < len(config.Options)-1 {
-					currentSelection++
-				} else {
-					currentSelection = 0
+				cursor = digit - 1
+				if config.SelectOnNumber {
+					return accept(visible[cursor])
 				}
-				refreshSelectDisplay(config, currentSelection)
+				break
+			}
+			filter += string(key.Rune)
+			visible = filterSelectOptions(config.Options, filter)
+			cursor, offset = 0, 0
+
+		case KeyUp:
+			if len(visible) == 0 {
+				continue
+			}
+			if cursor > 0 {
+				cursor--
+			} else {
+				cursor = len(visible) - 1
+			}
+
+		case KeyDown:
+			if len(visible) == 0 {
+				continue
 			}
+			if cursor < len(visible)-1 {
+				cursor++
+			} else {
+				cursor = 0
+			}
+
+		default:
+			continue
+		}
+
+		offset = scrollSelectWindow(cursor, offset, selectPageSize(config, len(visible)))
+		lines = refreshSelectDisplay(config, visible, cursor, offset, filter, lines)
+	}
+}
+
+// filterSelectOptions returns the indexes of the options matching filter, best matches
+// first. Options match when they contain the filter or its characters in order, ignoring case
+func filterSelectOptions(options []string, filter string) []int {
+	indexes := make([]int, 0, len(options))
+	if filter == "" {
+		for i := range options {
+			indexes = append(indexes, i)
+		}
+		return indexes
+	}
+
+	filter = strings.ToLower(filter)
+	scores := make(map[int]int)
+	for i, option := range options {
+		option = strings.ToLower(option)
+		if strings.Contains(option, filter) || isSubsequence(filter, option) {
+			indexes = append(indexes, i)
+			scores[i], _ = fuzzyMatchScore(filter, option)
+		}
+	}
+
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return scores[indexes[a]] > scores[indexes[b]]
+	})
+	return indexes
+}
+
+// isSubsequence reports whether all runes of sub appear in s in order
+func isSubsequence(sub, s string) bool {
+	subRunes := []rune(sub)
+	i := 0
+	for _, r := range s {
+		if i < len(subRunes) && r == subRunes[i] {
+			i++
 		}
 	}
+	return i == len(subRunes)
 }
 
 func selectFallback(config SelectConfig) (int, error) {
@@ -241,23 +446,91 @@ func selectFallback(config SelectConfig) (int, error) {
 	return selection - 1, nil
 }
 
-func displaySelectOptions(config SelectConfig, currentSelection int) {
-	fmt.Printf("%s %s\n", Info.Sprint("?"), config.Label)
-	fmt.Printf("%s\n", Muted.Sprint("(↑/↓ navigate, Enter select, Esc cancel)"))
-	
-	for i, option := range config.Options {
-		if i == currentSelection {
+// displaySelectOptions prints the filtered options visible from offset and returns the number of lines printed
+func displaySelectOptions(config SelectConfig, visible []int, cursor, offset int, filter string) int {
+	if filter != "" {
+		fmt.Printf("%s %s %s\n", Info.Sprint("?"), config.Label, Info.Sprint(filter))
+	} else {
+		fmt.Printf("%s %s\n", Info.Sprint("?"), config.Label)
+	}
+	if filter == "" {
+		fmt.Printf("%s\n", Muted.Sprint("(↑/↓ navigate, 1-9 jump, type to filter, Enter select, Esc cancel)"))
+	} else {
+		fmt.Printf("%s\n", Muted.Sprint("(↑/↓ navigate, type to filter, Enter select, Esc cancel)"))
+	}
+
+	if len(visible) == 0 {
+		fmt.Printf("    %s\n", Muted.Sprint("No matches"))
+		return 3
+	}
+
+	pageSize := selectPageSize(config, len(visible))
+	end := min(offset+pageSize, len(visible))
+	windowed := pageSize < len(visible)
+	lines := 2
+
+	if windowed {
+		fmt.Println(moreIndicator("↑", offset))
+		lines++
+	}
+
+	for i := offset; i < end; i++ {
+		option := config.Options[visible[i]]
+		if i == cursor {
 			fmt.Printf("  %s %s\n", Success.Sprint("→"), BoldColor.Sprint(option))
 		} else {
 			fmt.Printf("    %s\n", option)
 		}
+		lines++
+	}
+
+	if windowed {
+		fmt.Println(moreIndicator("↓", len(visible)-end))
+		lines++
 	}
+
+	return lines
 }
 
-func refreshSelectDisplay(config SelectConfig, currentSelection int) {
-	fmt.Printf("\033[%dA", len(config.Options)+2)
+func refreshSelectDisplay(config SelectConfig, visible []int, cursor, offset int, filter string, lines int) int {
+	fmt.Printf("\033[%dA", lines)
 	fmt.Print("\033[J")
-	displaySelectOptions(config, currentSelection)
+	return displaySelectOptions(config, visible, cursor, offset, filter)
+}
+
+// selectPageSize returns how many of total options fit in the select viewport
+func selectPageSize(config SelectConfig, total int) int {
+	pageSize := config.PageSize
+	if pageSize <= 0 {
+		// Leave room for the label, hint and scroll indicators
+		pageSize = GetTerminal().Height() - 5
+	}
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	if pageSize > total {
+		pageSize = total
+	}
+	return pageSize
+}
+
+// scrollSelectWindow moves the viewport offset just enough to keep the cursor visible
+func scrollSelectWindow(cursor, offset, pageSize int) int {
+	if cursor < offset {
+		return cursor
+	}
+	if cursor >= offset+pageSize {
+		return cursor - pageSize + 1
+	}
+	return offset
+}
+
+// moreIndicator renders the "↑ N more" line shown above or below a scrolled list
+func moreIndicator(arrow string, count int) string {
+	if count <= 0 {
+		return ""
+	}
+	return Muted.Sprintf("  %s %d more", arrow, count)
 }
 
 func clearSelectDisplay(lines int) {
@@ -285,7 +558,9 @@ func multiSelectInteractive(config SelectConfig) ([]int, error) {
 	HideCursor()
 	defer ShowCursor()
 
-	displayMultiSelectOptions(config, currentSelection, selected)
+	offset := 0
+	warning := ""
+	lines := displayMultiSelectOptions(config, currentSelection, offset, selected, warning)
 
 	oldState, err := term.MakeRaw(int(os.Stdin.Fd()))
 	if err != nil {
@@ -294,69 +569,95 @@ func multiSelectInteractive(config SelectConfig) ([]int, error) {
 	defer term.Restore(int(os.Stdin.Fd()), oldState)
 
 	for {
-		b := make([]byte, 4)
-		n, err := os.Stdin.Read(b)
+		key, err := readKey(context.Background())
 		if err != nil {
 			return nil, err
 		}
 
-		if n == 1 {
-			switch b[0] {
-			case 13:
-				clearMultiSelectDisplay(len(config.Options) + 2)
-				var result []int
-				for i := 0; i < len(config.Options); i++ {
-					if selected[i] {
-						result = append(result, i)
-					}
-				}
-				
-				fmt.Printf("%s %s\n", Info.Sprint("?"), config.Label)
-				if len(result) > 0 {
-					fmt.Printf("  %s Selected %d option(s)\n", Success.Sprint("→"), len(result))
-				} else {
-					fmt.Printf("  %s No options selected\n", Warning.Sprint("→"))
-				}
-				return result, nil
-				
-			case 27:
-				if n == 1 {
-					clearMultiSelectDisplay(len(config.Options) + 2)
-					return nil, fmt.Errorf("selection cancelled")
+		switch {
+		case key.Type == KeyEnter:
+			var result []int
+			for i := 0; i < len(config.Options); i++ {
+				if selected[i] {
+					result = append(result, i)
 				}
-				
-			case 32:
-				selected[currentSelection] = !selected[currentSelection]
-				refreshMultiSelectDisplay(config, currentSelection, selected)
-				
-			case 'q', 'Q':
-				clearMultiSelectDisplay(len(config.Options) + 2)
-				return nil, fmt.Errorf("selection cancelled")
 			}
-		} else if n >= 3 && b[0] == 27 && b[1] == 91 {
-			switch b[2] {
-			case 65:
-				if currentSelection > 0 {
-					currentSelection--
-				} else {
-					currentSelection = len(config.Options) - 1
-				}
-				refreshMultiSelectDisplay(config, currentSelection, selected)
-				
-			case 66:
-				if currentSelection < len(config.Options)-1 {
-					currentSelection++
-				} else {
-					currentSelection = 0
+
+			if warning = selectionCountWarning(config, len(result)); warning != "" {
+				lines = refreshMultiSelectDisplay(config, currentSelection, offset, selected, warning, lines)
+				continue
+			}
+
+			clearMultiSelectDisplay(lines)
+			fmt.Printf("%s %s\n", Info.Sprint("?"), config.Label)
+			if len(result) > 0 {
+				fmt.Printf("  %s Selected %d option(s)\n", Success.Sprint("→"), len(result))
+			} else {
+				fmt.Printf("  %s No options selected\n", Warning.Sprint("→"))
+			}
+			return result, nil
+
+		case key.Type == KeyEsc || key.Type == KeyCtrlC:
+			clearMultiSelectDisplay(lines)
+			return nil, fmt.Errorf("selection cancelled")
+
+		case key.Type == KeyUp:
+			if currentSelection > 0 {
+				currentSelection--
+			} else {
+				currentSelection = len(config.Options) - 1
+			}
+			offset = scrollSelectWindow(currentSelection, offset, selectPageSize(config, len(config.Options)))
+			lines = refreshMultiSelectDisplay(config, currentSelection, offset, selected, warning, lines)
+
+		case key.Type == KeyDown:
+			if currentSelection < len(config.Options)-1 {
+				currentSelection++
+			} else {
+				currentSelection = 0
+			}
+			offset = scrollSelectWindow(currentSelection, offset, selectPageSize(config, len(config.Options)))
+			lines = refreshMultiSelectDisplay(config, currentSelection, offset, selected, warning, lines)
+
+		case key.Type != KeyChar || key.Alt:
+			continue
+
+		case key.Rune == ' ':
+			selected[currentSelection] = !selected[currentSelection]
+			warning = ""
+			lines = refreshMultiSelectDisplay(config, currentSelection, offset, selected, warning, lines)
+
+		case key.Rune == 'a' || key.Rune == 'A':
+			allSelected := true
+			for i := range config.Options {
+				if !selected[i] {
+					allSelected = false
+					break
 				}
-				refreshMultiSelectDisplay(config, currentSelection, selected)
 			}
+			for i := range config.Options {
+				selected[i] = !allSelected
+			}
+			warning = ""
+			lines = refreshMultiSelectDisplay(config, currentSelection, offset, selected, warning, lines)
+
+		case key.Rune == 'i' || key.Rune == 'I':
+			for i := range config.Options {
+				selected[i] = !selected[i]
+			}
+			warning = ""
+			lines = refreshMultiSelectDisplay(config, currentSelection, offset, selected, warning, lines)
+
+		case key.Rune == 'q' || key.Rune == 'Q':
+			clearMultiSelectDisplay(lines)
+			return nil, fmt.Errorf("selection cancelled")
 		}
 	}
 }
 
 func multiSelectFallback(config SelectConfig) ([]int, error) {
 	selected := make(map[int]bool)
+	warning := ""
 
 	for {
 		fmt.Print("\033[2J\033[H")
@@ -373,9 +674,15 @@ func multiSelectFallback(config SelectConfig) ([]int, error) {
 
 		fmt.Println("\nPress:")
 		fmt.Println("  1-" + strconv.Itoa(len(config.Options)) + ": Toggle option")
+		fmt.Println("  a: Toggle all")
+		fmt.Println("  i: Invert selection")
 		fmt.Println("  Enter: Confirm selection")
 		fmt.Println("  q: Quit")
 
+		if warning != "" {
+			Warning.Println(warning)
+		}
+
 		input, err := readLine()
 		if err != nil {
 			return nil, err
@@ -390,13 +697,36 @@ func multiSelectFallback(config SelectConfig) ([]int, error) {
 					result = append(result, i)
 				}
 			}
+			if warning = selectionCountWarning(config, len(result)); warning != "" {
+				continue
+			}
 			return result, nil
 		}
 
+		warning = ""
+
 		if input == "q" {
 			return nil, fmt.Errorf("selection cancelled")
 		}
 
+		if input == "a" || input == "i" {
+			allSelected := true
+			for i := range config.Options {
+				if !selected[i] {
+					allSelected = false
+					break
+				}
+			}
+			for i := range config.Options {
+				if input == "a" {
+					selected[i] = !allSelected
+				} else {
+					selected[i] = !selected[i]
+				}
+			}
+			continue
+		}
+
 		selection, err := strconv.Atoi(input)
 		if err != nil || selection < 1 || selection > len(config.Options) {
 			continue
@@ -407,11 +737,23 @@ func multiSelectFallback(config SelectConfig) ([]int, error) {
 	}
 }
 
-func displayMultiSelectOptions(config SelectConfig, currentSelection int, selected map[int]bool) {
+// displayMultiSelectOptions prints the options visible from offset and returns the number of lines printed
+func displayMultiSelectOptions(config SelectConfig, currentSelection, offset int, selected map[int]bool, warning string) int {
 	fmt.Printf("%s %s\n", Info.Sprint("?"), config.Label)
-	fmt.Printf("%s\n", Muted.Sprint("(↑/↓ navigate, Space select, Enter confirm, Esc cancel)"))
-	
-	for i, option := range config.Options {
+	fmt.Printf("%s\n", Muted.Sprint("(↑/↓ navigate, Space select, a all, i invert, Enter confirm, Esc cancel)"))
+
+	pageSize := selectPageSize(config, len(config.Options))
+	end := min(offset+pageSize, len(config.Options))
+	windowed := pageSize < len(config.Options)
+	lines := 2
+
+	if windowed {
+		fmt.Println(moreIndicator("↑", offset))
+		lines++
+	}
+
+	for i := offset; i < end; i++ {
+		option := config.Options[i]
 		marker := "○"
 		if selected[i] {
 			marker = Success.Sprint("●")
@@ -422,13 +764,37 @@ func displayMultiSelectOptions(config SelectConfig, currentSelection int, select
 		} else {
 			fmt.Printf("    %s %s\n", marker, option)
 		}
+		lines++
+	}
+
+	if windowed {
+		fmt.Println(moreIndicator("↓", len(config.Options)-end))
+		lines++
+	}
+
+	if warning != "" {
+		fmt.Printf("  %s\n", Warning.Sprint(warning))
+		lines++
 	}
+
+	return lines
 }
 
-func refreshMultiSelectDisplay(config SelectConfig, currentSelection int, selected map[int]bool) {
-	fmt.Printf("\033[%dA", len(config.Options)+2)
+func refreshMultiSelectDisplay(config SelectConfig, currentSelection, offset int, selected map[int]bool, warning string, lines int) int {
+	fmt.Printf("\033[%dA", lines)
 	fmt.Print("\033[J")
-	displayMultiSelectOptions(config, currentSelection, selected)
+	return displayMultiSelectOptions(config, currentSelection, offset, selected, warning)
+}
+
+// selectionCountWarning returns a message when count is outside the config's Min/Max range
+func selectionCountWarning(config SelectConfig, count int) string {
+	if config.Min > 0 && count < config.Min {
+		return fmt.Sprintf("Select at least %d option(s)", config.Min)
+	}
+	if config.Max > 0 && count > config.Max {
+		return fmt.Sprintf("Select at most %d option(s)", config.Max)
+	}
+	return ""
 }
 
 // clearMultiSelectDisplay clears the multi-selection display
@@ -499,6 +865,32 @@ func AskNumber(label string) (int, error) {
 	return strconv.Atoi(str)
 }
 
+// AskFloat prompts for a decimal number input
+func AskFloat(label string) (float64, error) {
+	str, err := Input(InputConfig{
+		Label:    label,
+		Required: true,
+		Validate: FloatValidator,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(strings.TrimSpace(str), 64)
+}
+
+// AskNumberInRange prompts for a whole number between min and max inclusive
+func AskNumberInRange(label string, min, max int) (int, error) {
+	str, err := Input(InputConfig{
+		Label:    fmt.Sprintf("%s (%d-%d)", label, min, max),
+		Required: true,
+		Validate: RangeValidator(min, max),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(str))
+}
+
 // AskConfirm prompts for a yes/no confirmation
 func AskConfirm(label string) (bool, error) {
 	return Confirm(ConfirmConfig{
@@ -523,6 +915,29 @@ func AskMultiChoice(label string, options ...string) ([]int, error) {
 	})
 }
 
+// AskChoiceValue prompts for a single choice from options and returns the chosen option
+func AskChoiceValue(label string, options ...string) (string, error) {
+	index, err := AskChoice(label, options...)
+	if err != nil {
+		return "", err
+	}
+	return options[index], nil
+}
+
+// AskMultiChoiceValues prompts for multiple choices from options and returns the chosen options
+func AskMultiChoiceValues(label string, options ...string) ([]string, error) {
+	indexes, err := AskMultiChoice(label, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, len(indexes))
+	for i, index := range indexes {
+		values[i] = options[index]
+	}
+	return values, nil
+}
+
 // buildInputPrompt builds the input prompt display
 func buildInputPrompt(config InputConfig) string {
 	prompt := Info.Sprint("? ") + config.Label
@@ -543,27 +958,61 @@ func buildInputPrompt(config InputConfig) string {
 	return prompt
 }
 
+// readLine reads a line from stdin through the shared line reader
 func readLine() (string, error) {
-	reader := bufio.NewReader(os.Stdin)
-	line, _, err := reader.ReadLine()
-	if err != nil {
-		return "", err
-	}
-	return strings.TrimRightFunc(string(line), unicode.IsSpace), nil
+	return readLineContext(context.Background())
 }
 
-func readPassword() (string, error) {
-	if !term.IsTerminal(int(os.Stdin.Fd())) {
-		return readLine()
-	}
+// stdinLines reads lines from stdin for the prompts used when it is not a terminal.
+// Like stdinKeys, a read abandoned because its context ended stays in flight and its
+// line goes to the next caller, and a single buffered reader is kept so input read
+// ahead of the current line is not lost. Replacing os.Stdin starts a new reader
+var stdinLines struct {
+	mu       sync.Mutex
+	source   *os.File
+	reader   *bufio.Reader
+	inFlight bool
+	results  chan lineResult
+}
 
-	password, err := term.ReadPassword(int(os.Stdin.Fd()))
-	if err != nil {
-		return "", err
-	}
+type lineResult struct {
+	line string
+	err  error
+}
 
-	fmt.Println()
-	return string(password), nil
+// readLineContext reads a line from stdin, returning ctx.Err() if the context ends first
+func readLineContext(ctx context.Context) (string, error) {
+	stdinLines.mu.Lock()
+	if stdinLines.source != os.Stdin {
+		stdinLines.source = os.Stdin
+		stdinLines.reader = bufio.NewReader(os.Stdin)
+		stdinLines.results = make(chan lineResult, 1)
+		stdinLines.inFlight = false
+	}
+	if !stdinLines.inFlight {
+		stdinLines.inFlight = true
+		reader, results := stdinLines.reader, stdinLines.results
+		go func() {
+			line, err := reader.ReadString('\n')
+			if err == io.EOF && line != "" {
+				err = nil
+			}
+			results <- lineResult{strings.TrimRightFunc(line, unicode.IsSpace), err}
+		}()
+	}
+	results := stdinLines.results
+	stdinLines.mu.Unlock()
+
+	select {
+	case r := <-results:
+		stdinLines.mu.Lock()
+		stdinLines.inFlight = false
+		stdinLines.mu.Unlock()
+		return r.line, r.err
+	case <-ctx.Done():
+		fmt.Println()
+		return "", ctx.Err()
+	}
 }
 
 func EmailValidator(email string) error {
@@ -606,6 +1055,27 @@ func NumberValidator(input string) error {
 	return nil
 }
 
+func FloatValidator(input string) error {
+	_, err := strconv.ParseFloat(strings.TrimSpace(input), 64)
+	if err != nil {
+		return fmt.Errorf("must be a valid number")
+	}
+	return nil
+}
+
+func RangeValidator(min, max int) func(string) error {
+	return func(input string) error {
+		value, err := strconv.Atoi(strings.TrimSpace(input))
+		if err != nil {
+			return fmt.Errorf("must be a valid number")
+		}
+		if value < min || value > max {
+			return fmt.Errorf("must be between %d and %d", min, max)
+		}
+		return nil
+	}
+}
+
 func URLValidator(url string) error {
 	url = strings.ToLower(url)
 	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
@@ -0,0 +1,44 @@
+package clime
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestConfirmTimeoutKeepsNextLine(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin := os.Stdin
+	os.Stdin = r
+	defer func() {
+		os.Stdin = stdin
+		w.Close()
+		r.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := ConfirmContext(ctx, ConfirmConfig{Label: "First"}); err == nil {
+		t.Fatal("ConfirmContext with no input did not time out")
+	}
+
+	if _, err := w.WriteString("y\nn\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range []bool{true, false} {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		got, err := ConfirmContext(ctx, ConfirmConfig{Label: "Next"})
+		cancel()
+		if err != nil {
+			t.Fatalf("prompt %d after the timeout: %v", i+1, err)
+		}
+		if got != want {
+			t.Errorf("prompt %d after the timeout = %v, want %v", i+1, got, want)
+		}
+	}
+}
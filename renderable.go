@@ -0,0 +1,45 @@
+package clime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Renderable is implemented by every component that can draw itself as a string,
+// so components of different kinds can be kept together and printed alike
+type Renderable interface {
+	Render() string
+}
+
+var (
+	_ Renderable = (*Box)(nil)
+	_ Renderable = (*Table)(nil)
+	_ Renderable = (*Banner)(nil)
+	_ Renderable = (*Tree)(nil)
+	_ Renderable = (*BarChart)(nil)
+	_ Renderable = (*PieChart)(nil)
+	_ Renderable = (*Histogram)(nil)
+	_ Renderable = (*LineChart)(nil)
+	_ Renderable = (*ProgressBar)(nil)
+	_ Renderable = (*MultiBar)(nil)
+)
+
+// Print renders r to the package output (see SetOutput), ending it with a newline
+// whether or not the component's own output has one. Empty output prints nothing
+func Print(r Renderable) {
+	output := r.Render()
+	if output == "" {
+		return
+	}
+	if !strings.HasSuffix(output, "\n") {
+		output += "\n"
+	}
+	fmt.Fprint(outputWriter(), output)
+}
+
+// PrintAll prints each component in turn with Print
+func PrintAll(rs ...Renderable) {
+	for _, r := range rs {
+		Print(r)
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !unix
+
+package clime
+
+import "os"
+
+// notifyResize reports false because this platform has no resize signal
+func notifyResize(ch chan<- os.Signal) bool {
+	return false
+}
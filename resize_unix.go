@@ -0,0 +1,15 @@
+//go:build unix
+
+package clime
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyResize relays SIGWINCH to ch
+func notifyResize(ch chan<- os.Signal) bool {
+	signal.Notify(ch, syscall.SIGWINCH)
+	return true
+}
@@ -1,6 +1,8 @@
 package clime
 
 import (
+	"os"
+	"os/signal"
 	"sync"
 )
 
@@ -36,6 +38,8 @@ var (
 type ResponsiveManager struct {
 	terminal          *Terminal
 	currentBreakpoint BreakpointSize
+	resizeCallbacks   []func(BreakpointSize)
+	stopWatch         func()
 	mu                sync.RWMutex
 }
 
@@ -54,7 +58,7 @@ func GetResponsiveManager() *ResponsiveManager {
 // NewResponsiveManager creates a new responsive manager
 func NewResponsiveManager() *ResponsiveManager {
 	rm := &ResponsiveManager{
-		terminal: NewTerminal(),
+		terminal: GetTerminal(),
 	}
 
 	rm.updateBreakpoint()
@@ -89,15 +93,83 @@ func (rm *ResponsiveManager) IsBreakpointOrSmaller(size BreakpointSize) bool {
 	return rm.GetCurrentBreakpoint() <= size
 }
 
-// RefreshBreakpoint manually refreshes the current breakpoint
+// RefreshBreakpoint re-queries the terminal size and refreshes the current breakpoint
 func (rm *ResponsiveManager) RefreshBreakpoint() {
-	rm.terminal = NewTerminal()
+	rm.currentTerminal().Refresh()
 	rm.updateBreakpoint()
 }
 
+// OnResize registers a callback invoked with the current breakpoint each time
+// WatchResize observes a terminal resize
+func (rm *ResponsiveManager) OnResize(callback func(BreakpointSize)) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	rm.resizeCallbacks = append(rm.resizeCallbacks, callback)
+}
+
+// WatchResize refreshes the breakpoint and runs the OnResize callbacks whenever the
+// terminal is resized. On Unix this listens for SIGWINCH; on other platforms it does
+// nothing. Calling it again while watching is a no-op. The returned function stops
+// watching.
+func (rm *ResponsiveManager) WatchResize() func() {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	if rm.stopWatch != nil {
+		return rm.stopWatch
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	if !notifyResize(sigCh) {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer signal.Stop(sigCh)
+
+		for {
+			select {
+			case <-sigCh:
+				rm.RefreshBreakpoint()
+				bp := rm.GetCurrentBreakpoint()
+
+				rm.mu.RLock()
+				callbacks := append([]func(BreakpointSize){}, rm.resizeCallbacks...)
+				rm.mu.RUnlock()
+
+				for _, callback := range callbacks {
+					callback(bp)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	rm.stopWatch = func() {
+		once.Do(func() {
+			close(done)
+			rm.mu.Lock()
+			rm.stopWatch = nil
+			rm.mu.Unlock()
+		})
+	}
+
+	return rm.stopWatch
+}
+
+// currentTerminal returns the terminal the breakpoint was last computed from
+func (rm *ResponsiveManager) currentTerminal() *Terminal {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+	return rm.terminal
+}
+
 // updateBreakpoint updates the current breakpoint based on terminal width
 func (rm *ResponsiveManager) updateBreakpoint() {
-	width := rm.terminal.Width()
+	width := rm.currentTerminal().Width()
 
 	var newBreakpoint BreakpointSize
 	for i, bp := range Breakpoints {
@@ -202,7 +274,7 @@ func (rc *ResponsiveConfig) GetConfigForBreakpoint(bp BreakpointSize) *ElementCo
 // SmartWidth sizing functions
 func SmartWidth(percentage float64) int {
 	rm := GetResponsiveManager()
-	terminalWidth := rm.terminal.Width()
+	terminalWidth := rm.currentTerminal().Width()
 
 	baseWidth := int(float64(terminalWidth) * percentage)
 
@@ -281,7 +353,7 @@ func min(a, b int) int {
 // GetOptimalColumns returns optimal number of columns for current screen size
 func GetOptimalColumns(contentWidth int) int {
 	rm := GetResponsiveManager()
-	availableWidth := rm.terminal.Width() - SmartMargin()*2
+	availableWidth := rm.currentTerminal().Width() - SmartMargin()*2
 
 	if contentWidth <= 0 {
 		contentWidth = 20
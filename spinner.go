@@ -2,6 +2,9 @@ package clime
 
 import (
 	"fmt"
+	"io"
+	"math"
+	"strings"
 	"sync"
 	"time"
 )
@@ -52,18 +55,41 @@ var (
 		Frames:   []string{"▁", "▃", "▄", "▅", "▆", "▇", "█", "▇", "▆", "▅", "▄", "▃"},
 		Interval: 120 * time.Millisecond,
 	}
+	SpinnerQuarters = SpinnerStyle{
+		Frames:   []string{"◴", "◷", "◶", "◵"},
+		Interval: 120 * time.Millisecond,
+	}
 )
 
+// determinatePulse is how long a determinate spinner's frame stays lit or dimmed
+// to show it is still alive between progress updates
+const determinatePulse = 500 * time.Millisecond
+
 type Spinner struct {
-	style      SpinnerStyle
-	color      *Color
-	message    string
-	prefix     string
-	suffix     string
-	running    bool
-	stopCh     chan bool
-	mu         sync.RWMutex
-	hideCursor bool
+	style       SpinnerStyle
+	color       *Color
+	message     string
+	prefix      string
+	suffix      string
+	running     bool
+	stopCh      chan bool
+	mu          sync.RWMutex
+	hideCursor  bool
+	lastWidth   int
+	writer      io.Writer
+	interactive bool
+	showElapsed bool
+	startTime   time.Time
+	managed     bool
+	finalLine   string
+	release     func()
+	determinate bool
+	progress    float64
+	ellipsis    bool
+	owner       *MultiSpinner
+	// drawMu serialises drawing the spinner line with Log and Stop; lastOutput is the line last drawn
+	drawMu     sync.Mutex
+	lastOutput string
 }
 
 // NewSpinner creates a new spinner with the default style
@@ -73,6 +99,7 @@ func NewSpinner() *Spinner {
 		color:      CyanColor,
 		stopCh:     make(chan bool),
 		hideCursor: true,
+		writer:     outputWriter(),
 	}
 }
 
@@ -108,6 +135,15 @@ func (s *Spinner) WithPrefix(prefix string) *Spinner {
 	return s
 }
 
+// WithAnimatedEllipsis cycles zero to three dots after the message, one more each
+// frame. The dots take a fixed width so a suffix after them doesn't move
+func (s *Spinner) WithAnimatedEllipsis(enabled bool) *Spinner {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ellipsis = enabled
+	return s
+}
+
 // WithSuffix sets a suffix for the spinner
 func (s *Spinner) WithSuffix(suffix string) *Spinner {
 	s.mu.Lock()
@@ -116,6 +152,41 @@ func (s *Spinner) WithSuffix(suffix string) *Spinner {
 	return s
 }
 
+// WithWriter sets the writer the spinner renders to (defaults to the package output, see SetOutput).
+// Writers that are not a terminal get a single static line instead of an animation
+func (s *Spinner) WithWriter(w io.Writer) *Spinner {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writer = w
+	return s
+}
+
+// ShowElapsed controls whether to append the time elapsed since Start to the output
+func (s *Spinner) ShowElapsed(show bool) *Spinner {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.showElapsed = show
+	return s
+}
+
+// SetProgress switches the spinner to determinate mode, showing the fraction done
+// (0-1) as a percentage. The frame is picked from the style's frames in proportion
+// to the progress and pulses between updates to show the task is still running
+func (s *Spinner) SetProgress(progress float64) *Spinner {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.determinate = true
+	s.progress = math.Max(0, math.Min(progress, 1))
+	return s
+}
+
+// progressFrame returns the frame matching the current progress
+func (s *Spinner) progressFrame() string {
+	frames := s.style.Frames
+	index := int(s.progress * float64(len(frames)))
+	return frames[min(index, len(frames)-1)]
+}
+
 // HideCursor controls whether to hide the cursor while spinning
 func (s *Spinner) HideCursor(hide bool) *Spinner {
 	s.mu.Lock()
@@ -133,10 +204,21 @@ func (s *Spinner) Start() *Spinner {
 	}
 	s.running = true
 	s.stopCh = make(chan bool)
+	s.lastWidth = 0
+	s.startTime = time.Now()
+	s.interactive = isTerminalWriter(s.writer)
 	s.mu.Unlock()
 
+	if !s.interactive {
+		s.mu.RLock()
+		fmt.Fprintln(s.writer, s.buildOutput(s.style.Frames[0], maxEllipsisDots))
+		s.mu.RUnlock()
+		return s
+	}
+
 	if s.hideCursor {
-		HideCursor()
+		s.release = restoreOnInterrupt(s.writer)
+		hideCursorTo(s.writer)
 	}
 
 	go s.animate()
@@ -154,34 +236,56 @@ func (s *Spinner) Stop() {
 	close(s.stopCh)
 	s.mu.Unlock()
 
-	ClearLine()
+	if !s.interactive {
+		return
+	}
+
+	s.drawMu.Lock()
+	s.clearOutput()
+	s.lastOutput = ""
+	s.drawMu.Unlock()
 	if s.hideCursor {
-		ShowCursor()
+		showCursorTo(s.writer)
+	}
+	if s.release != nil {
+		s.release()
+		s.release = nil
 	}
 }
 
 // Success stops the spinner and shows a success message
 func (s *Spinner) Success(message string) {
-	s.Stop()
-	fmt.Print(Success.Sprint("✓ ") + message + "\n")
+	s.complete(Success.Sprint("✓ ") + message)
 }
 
 // Error stops the spinner and shows an error message
 func (s *Spinner) Error(message string) {
-	s.Stop()
-	fmt.Print(Error.Sprint("✗ ") + message + "\n")
+	s.complete(Error.Sprint("✗ ") + message)
 }
 
 // Warning stops the spinner and shows a warning message
 func (s *Spinner) Warning(message string) {
-	s.Stop()
-	fmt.Print(Warning.Sprint("⚠ ") + message + "\n")
+	s.complete(Warning.Sprint("⚠ ") + message)
 }
 
 // Info stops the spinner and shows an info message
 func (s *Spinner) Info(message string) {
+	s.complete(Info.Sprint("ℹ ") + message)
+}
+
+// complete stops the spinner and prints its final line. Spinners owned by a
+// MultiSpinner keep the line so it replaces their animation on the next frame
+func (s *Spinner) complete(line string) {
+	s.mu.Lock()
+	if s.managed {
+		s.finalLine = line
+		s.mu.Unlock()
+		return
+	}
+	s.mu.Unlock()
+
 	s.Stop()
-	fmt.Print(Info.Sprint("ℹ ") + message + "\n")
+	fmt.Fprint(s.writer, line+"\n")
 }
 
 // UpdateMessage updates the spinner message while it's running
@@ -204,47 +308,321 @@ func (s *Spinner) animate() {
 	defer ticker.Stop()
 
 	frameIndex := 0
+	dotPhase := 0
 	for {
 		select {
 		case <-s.stopCh:
 			return
 		case <-ticker.C:
+			GetTerminal().Refresh()
+
 			s.mu.RLock()
 			frame := s.style.Frames[frameIndex]
-			output := s.buildOutput(frame)
+			output := s.buildOutput(frame, dotPhase)
 			s.mu.RUnlock()
 
-			ClearLine()
-			fmt.Print(output)
+			s.drawMu.Lock()
+			if s.IsRunning() {
+				s.draw(output)
+			}
+			s.drawMu.Unlock()
 
 			frameIndex = (frameIndex + 1) % len(s.style.Frames)
+			dotPhase = (dotPhase + 1) % (maxEllipsisDots + 1)
 		}
 	}
 }
 
-// buildOutput builds the complete spinner output string
-func (s *Spinner) buildOutput(frame string) string {
+// draw replaces the spinner line with output. The caller holds drawMu
+func (s *Spinner) draw(output string) {
+	s.clearOutput()
+	fmt.Fprint(s.writer, output)
+
+	s.mu.Lock()
+	s.lastWidth = getVisualWidth(output)
+	s.mu.Unlock()
+	s.lastOutput = output
+}
+
+// Log prints msg on its own line above the running spinner, which is redrawn
+// below it. Printing to the terminal directly with fmt.Print while a spinner is
+// animating is unsafe, as the text gets mixed into the spinner line; use Log
+// instead. When the spinner is not animating msg is simply printed
+func (s *Spinner) Log(msg string) {
+	s.mu.RLock()
+	owner, running, interactive := s.owner, s.running, s.interactive
+	s.mu.RUnlock()
+
+	if owner != nil {
+		owner.Log(msg)
+		return
+	}
+
+	s.drawMu.Lock()
+	defer s.drawMu.Unlock()
+
+	if !running || !interactive {
+		fmt.Fprintln(s.writer, msg)
+		return
+	}
+
+	s.clearOutput()
+	fmt.Fprintln(s.writer, msg)
+	s.mu.Lock()
+	s.lastWidth = 0
+	s.mu.Unlock()
+	if s.lastOutput != "" {
+		s.draw(s.lastOutput)
+	}
+}
+
+// maxEllipsisDots is the number of dots an animated ellipsis grows to before starting over
+const maxEllipsisDots = 3
+
+// buildOutput builds the complete spinner output string, ending the message with
+// the given number of dots when the animated ellipsis is on
+func (s *Spinner) buildOutput(frame string, dots int) string {
 	var output string
 
 	if s.prefix != "" {
 		output += s.prefix + " "
 	}
 
-	if s.color != nil {
-		output += s.color.Sprint(frame)
+	color := s.color
+	if s.determinate {
+		frame = s.progressFrame()
+		if time.Since(s.startTime)/determinatePulse%2 == 1 {
+			color = DimColor
+		}
+	}
+
+	if color != nil {
+		output += color.Sprint(frame)
 	} else {
 		output += frame
 	}
 
+	if s.determinate {
+		output += fmt.Sprintf(" %3d%%", int(s.progress*100))
+	}
+
 	if s.message != "" {
 		output += " " + s.message
+		if s.ellipsis {
+			output += strings.Repeat(".", dots)
+			// Keep whatever follows the message in place as the dots change
+			if s.suffix != "" || s.showElapsed {
+				output += strings.Repeat(" ", maxEllipsisDots-dots)
+			}
+		}
 	}
 
 	if s.suffix != "" {
 		output += " " + s.suffix
 	}
 
-	return output
+	if s.showElapsed {
+		output += " " + formatDuration(time.Since(s.startTime))
+	}
+
+	return TruncateString(output, GetTerminal().Width()-1)
+}
+
+// clearOutput clears the spinner line, including any rows the previous output wrapped onto
+func (s *Spinner) clearOutput() {
+	s.mu.RLock()
+	lastWidth := s.lastWidth
+	s.mu.RUnlock()
+
+	width := GetTerminal().Width()
+	if width > 0 && lastWidth > width {
+		moveCursorUpTo(s.writer, (lastWidth-1)/width)
+		fmt.Fprint(s.writer, "\r\033[J")
+		return
+	}
+
+	clearLineTo(s.writer)
+}
+
+// managedLine returns the line of a spinner owned by a MultiSpinner after elapsed animation time
+func (s *Spinner) managedLine(elapsed time.Duration) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.finalLine != "" {
+		return s.finalLine
+	}
+
+	interval := s.style.Interval
+	if interval <= 0 {
+		interval = SpinnerDots.Interval
+	}
+
+	step := int(elapsed / interval)
+	frame := s.style.Frames[step%len(s.style.Frames)]
+	return s.buildOutput(frame, step%(maxEllipsisDots+1))
+}
+
+// MultiSpinner renders several spinners at once, one per line
+type MultiSpinner struct {
+	spinners    []*Spinner
+	writer      io.Writer
+	running     bool
+	interactive bool
+	stopCh      chan bool
+	doneCh      chan bool
+	lines       int
+	release     func()
+	startTime   time.Time
+	mu          sync.RWMutex
+}
+
+// NewMultiSpinner creates a new multi-spinner
+func NewMultiSpinner() *MultiSpinner {
+	return &MultiSpinner{
+		spinners: make([]*Spinner, 0),
+		writer:   outputWriter(),
+	}
+}
+
+// WithWriter sets the writer the spinners render to (defaults to the package output, see SetOutput)
+func (m *MultiSpinner) WithWriter(w io.Writer) *MultiSpinner {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.writer = w
+	return m
+}
+
+// AddSpinner adds a spinner to the multi-spinner. Calling Success, Error, Warning
+// or Info on it afterwards marks its line as finished while the others keep spinning
+func (m *MultiSpinner) AddSpinner(spinner *Spinner) *MultiSpinner {
+	spinner.mu.Lock()
+	spinner.managed = true
+	spinner.owner = m
+	spinner.startTime = time.Now()
+	spinner.mu.Unlock()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.spinners = append(m.spinners, spinner)
+	return m
+}
+
+// Start starts animating all spinners
+func (m *MultiSpinner) Start() *MultiSpinner {
+	m.mu.Lock()
+	if m.running {
+		m.mu.Unlock()
+		return m
+	}
+	m.running = true
+	m.interactive = isTerminalWriter(m.writer)
+	m.stopCh = make(chan bool)
+	m.doneCh = make(chan bool)
+	m.lines = 0
+	m.startTime = time.Now()
+	m.mu.Unlock()
+
+	if !m.interactive {
+		close(m.doneCh)
+		return m
+	}
+
+	m.release = restoreOnInterrupt(m.writer)
+	hideCursorTo(m.writer)
+	go m.animate()
+	return m
+}
+
+// Stop stops the animation and leaves the final state of every spinner on screen
+func (m *MultiSpinner) Stop() {
+	m.mu.Lock()
+	if !m.running {
+		m.mu.Unlock()
+		return
+	}
+	m.running = false
+	close(m.stopCh)
+	m.mu.Unlock()
+
+	<-m.doneCh
+	m.render(time.Duration(0))
+
+	if m.interactive {
+		showCursorTo(m.writer)
+		m.release()
+	}
+}
+
+// animate runs the animation loop for all spinners
+func (m *MultiSpinner) animate() {
+	defer close(m.doneCh)
+
+	ticker := time.NewTicker(SpinnerDots.Interval)
+	defer ticker.Stop()
+
+	m.render(0)
+
+	for {
+		select {
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+			m.mu.RLock()
+			elapsed := time.Since(m.startTime)
+			m.mu.RUnlock()
+			m.render(elapsed)
+		}
+	}
+}
+
+// Log prints msg on its own line above the spinners, which are redrawn below it.
+// As with Spinner.Log, printing to the terminal directly while they animate is unsafe
+func (m *MultiSpinner) Log(msg string) {
+	GetTerminal().Refresh()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.running || !m.interactive {
+		fmt.Fprintln(m.writer, msg)
+		return
+	}
+
+	if m.lines > 0 {
+		fmt.Fprint(m.writer, "\r")
+		moveCursorUpTo(m.writer, m.lines)
+		fmt.Fprint(m.writer, "\033[J")
+	}
+	fmt.Fprintln(m.writer, msg)
+	m.lines = 0
+	m.draw(time.Since(m.startTime))
+}
+
+// render redraws every spinner line in place
+func (m *MultiSpinner) render(elapsed time.Duration) {
+	GetTerminal().Refresh()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.draw(elapsed)
+}
+
+// draw writes every spinner line over the ones drawn last time. The caller holds m.mu
+func (m *MultiSpinner) draw(elapsed time.Duration) {
+	if m.interactive && m.lines > 0 {
+		fmt.Fprint(m.writer, "\r")
+		moveCursorUpTo(m.writer, m.lines)
+	}
+
+	for _, spinner := range m.spinners {
+		if m.interactive {
+			clearLineTo(m.writer)
+		}
+		fmt.Fprintln(m.writer, spinner.managedLine(elapsed))
+	}
+
+	m.lines = len(m.spinners)
 }
 
 // ShowSpinner shows a spinner with a message and runs the provided function
@@ -1,103 +1,29 @@
 package clime
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+
+	"golang.org/x/term"
 )
 
-type TableStyle struct {
-	TopLeft     string
-	TopRight    string
-	BottomLeft  string
-	BottomRight string
-	Horizontal  string
-	Vertical    string
-	Cross       string
-	TopTee      string
-	BottomTee   string
-	LeftTee     string
-	RightTee    string
-}
+// TableStyle is the border style of a table
+type TableStyle = BorderStyle
 
 var (
-	TableStyleDefault = TableStyle{
-		TopLeft:     "┌",
-		TopRight:    "┐",
-		BottomLeft:  "└",
-		BottomRight: "┘",
-		Horizontal:  "─",
-		Vertical:    "│",
-		Cross:       "┼",
-		TopTee:      "┬",
-		BottomTee:   "┴",
-		LeftTee:     "├",
-		RightTee:    "┤",
-	}
-	TableStyleRounded = TableStyle{
-		TopLeft:     "╭",
-		TopRight:    "╮",
-		BottomLeft:  "╰",
-		BottomRight: "╯",
-		Horizontal:  "─",
-		Vertical:    "│",
-		Cross:       "┼",
-		TopTee:      "┬",
-		BottomTee:   "┴",
-		LeftTee:     "├",
-		RightTee:    "┤",
-	}
-	TableStyleBold = TableStyle{
-		TopLeft:     "┏",
-		TopRight:    "┓",
-		BottomLeft:  "┗",
-		BottomRight: "┛",
-		Horizontal:  "━",
-		Vertical:    "┃",
-		Cross:       "╋",
-		TopTee:      "┳",
-		BottomTee:   "┻",
-		LeftTee:     "┣",
-		RightTee:    "┫",
-	}
-	TableStyleDouble = TableStyle{
-		TopLeft:     "╔",
-		TopRight:    "╗",
-		BottomLeft:  "╚",
-		BottomRight: "╝",
-		Horizontal:  "═",
-		Vertical:    "║",
-		Cross:       "╬",
-		TopTee:      "╦",
-		BottomTee:   "╩",
-		LeftTee:     "╠",
-		RightTee:    "╣",
-	}
-	TableStyleSimple = TableStyle{
-		TopLeft:     "+",
-		TopRight:    "+",
-		BottomLeft:  "+",
-		BottomRight: "+",
-		Horizontal:  "-",
-		Vertical:    "|",
-		Cross:       "+",
-		TopTee:      "+",
-		BottomTee:   "+",
-		LeftTee:     "+",
-		RightTee:    "+",
-	}
-	TableStyleMinimal = TableStyle{
-		TopLeft:     " ",
-		TopRight:    " ",
-		BottomLeft:  " ",
-		BottomRight: " ",
-		Horizontal:  " ",
-		Vertical:    " ",
-		Cross:       " ",
-		TopTee:      " ",
-		BottomTee:   " ",
-		LeftTee:     " ",
-		RightTee:    " ",
-	}
+	TableStyleDefault = BorderStyleDefault
+	TableStyleRounded = BorderStyleRounded
+	TableStyleBold    = BorderStyleBold
+	TableStyleDouble  = BorderStyleDouble
+	TableStyleSimple  = BorderStyleSimple
+	TableStyleMinimal = BorderStyleMinimal
 )
 
 type TableAlignment int
@@ -108,11 +34,25 @@ const (
 	AlignRight
 )
 
+type OverflowMode int
+
+const (
+	OverflowTruncate OverflowMode = iota
+	OverflowWrap
+	OverflowEllipsisMiddle
+)
+
 type TableColumn struct {
 	Header    string
 	Width     int
+	Percent   float64
 	Alignment TableAlignment
 	Color     *Color
+	// MinWidth and MaxWidth bound the content width of an auto-sized column; 0 means no bound
+	MinWidth int
+	MaxWidth int
+	// Formatter, when set, turns each raw cell value into the text displayed
+	Formatter func(raw string) string
 }
 
 type Table struct {
@@ -122,28 +62,42 @@ type Table struct {
 	headerColor      *Color
 	borderColor      *Color
 	showHeader       bool
-	showBorders      bool
+	outerBorder      bool
+	columnSeparators bool
 	padding          int
 	autoResize       bool
 	maxWidth         int
+	overflow         OverflowMode
 	ResponsiveConfig *ResponsiveConfig
 	useSmartSizing   bool
+	smartRatio       float64
+	widths           []int
+	rowNumbers       bool
+	cellAlignments   map[[2]int]TableAlignment
+	horizontalScroll bool
+
+	// page, pageSize and pageTotal describe the page shown, for the footer set by WithPageInfo
+	page      int
+	pageSize  int
+	pageTotal int
 }
 
 // NewTable creates a new table
 func NewTable() *Table {
 	return &Table{
-		columns:        make([]TableColumn, 0),
-		rows:           make([][]string, 0),
-		style:          TableStyleDefault,
-		headerColor:    BoldColor,
-		borderColor:    DimColor,
-		showHeader:     true,
-		showBorders:    true,
-		padding:        SmartPadding(),
-		autoResize:     true,
-		maxWidth:       SmartWidth(0.95), // Use 95% of smart width
-		useSmartSizing: true,
+		columns:          make([]TableColumn, 0),
+		rows:             make([][]string, 0),
+		style:            TableStyleDefault,
+		headerColor:      DefaultTitleColor,
+		borderColor:      DefaultBorderColor,
+		showHeader:       true,
+		outerBorder:      true,
+		columnSeparators: true,
+		padding:          SmartPadding(),
+		autoResize:       true,
+		maxWidth:         SmartWidth(0.95), // Use 95% of smart width
+		useSmartSizing:   true,
+		smartRatio:       0.95,
 	}
 }
 
@@ -171,9 +125,24 @@ func (t *Table) ShowHeader(show bool) *Table {
 	return t
 }
 
-// ShowBorders controls whether to show borders
+// ShowBorders controls whether to show borders, both the outer border and the
+// separators between columns
 func (t *Table) ShowBorders(show bool) *Table {
-	t.showBorders = show
+	t.outerBorder = show
+	t.columnSeparators = show
+	return t
+}
+
+// ShowOuterBorder controls whether to show the border around the table
+func (t *Table) ShowOuterBorder(show bool) *Table {
+	t.outerBorder = show
+	return t
+}
+
+// ShowColumnSeparators controls whether to show the vertical lines between columns,
+// independently of the outer border
+func (t *Table) ShowColumnSeparators(show bool) *Table {
+	t.columnSeparators = show
 	return t
 }
 
@@ -191,6 +160,12 @@ func (t *Table) AutoResize(enable bool) *Table {
 	return t
 }
 
+// WithOverflow sets how cell content wider than its column is handled
+func (t *Table) WithOverflow(mode OverflowMode) *Table {
+	t.overflow = mode
+	return t
+}
+
 // WithMaxWidth sets the maximum table width
 func (t *Table) WithMaxWidth(width int) *Table {
 	if width > 0 {
@@ -203,10 +178,40 @@ func (t *Table) WithMaxWidth(width int) *Table {
 // WithSmartWidth enables smart responsive width sizing
 func (t *Table) WithSmartWidth(percentage float64) *Table {
 	t.maxWidth = SmartWidth(percentage)
+	t.smartRatio = percentage
 	t.useSmartSizing = true
 	return t
 }
 
+// WithRowNumbers prepends a right-aligned "#" column numbering the rows from 1 in
+// the order they are rendered. It is not one of the table's columns, so column
+// indexes passed to the SetColumn methods are unaffected
+func (t *Table) WithRowNumbers(enable bool) *Table {
+	t.rowNumbers = enable
+	return t
+}
+
+// WithHorizontalScroll makes PrintPaged on a terminal show the table in a viewer
+// instead of printing it page by page. Columns keep their natural width and only
+// those that fit the terminal are shown, with the first column pinned on the left;
+// left/right arrows scroll through the other columns and up/down or space through
+// the rows. Without a terminal PrintPaged still prints every column
+func (t *Table) WithHorizontalScroll(enable bool) *Table {
+	t.horizontalScroll = enable
+	return t
+}
+
+// WithPageInfo adds a footer below the table describing which page of a larger
+// result set it shows, e.g. "Page 2/5 · showing 21–40 of 97". page is 1-based and
+// total is the number of items across all pages. The footer is not a data row;
+// a pageSize of 0 removes it
+func (t *Table) WithPageInfo(page, pageSize, total int) *Table {
+	t.page = page
+	t.pageSize = max(pageSize, 0)
+	t.pageTotal = max(total, 0)
+	return t
+}
+
 // WithResponsiveConfig sets responsive configuration for different breakpoints
 func (t *Table) WithResponsiveConfig(config ResponsiveConfig) *Table {
 	t.ResponsiveConfig = &config
@@ -236,6 +241,17 @@ func (t *Table) AddColumnWithWidth(header string, width int) *Table {
 	return t
 }
 
+// AddColumnWithPercent adds a column sized as a fraction (0-1) of the table width
+func (t *Table) AddColumnWithPercent(header string, percent float64) *Table {
+	t.columns = append(t.columns, TableColumn{
+		Header:    header,
+		Percent:   percent,
+		Alignment: AlignLeft,
+		Color:     nil,
+	})
+	return t
+}
+
 // AddColumnWithConfig adds a column with full configuration
 func (t *Table) AddColumnWithConfig(column TableColumn) *Table {
 	t.columns = append(t.columns, column)
@@ -254,6 +270,95 @@ func (t *Table) AddRows(rows [][]string) *Table {
 	return t
 }
 
+// AddRowStruct adds a row from the exported fields of a struct or struct pointer,
+// formatting each value with fmt.Sprint. When the table has no columns yet they are
+// created from the fields, using a `clime:"header,align=right"` tag for the header
+// and alignment. Fields tagged `clime:"-"` are skipped.
+func (t *Table) AddRowStruct(v any) *Table {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return t
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return t
+	}
+
+	fields := structTableFields(value.Type())
+	if len(t.columns) == 0 {
+		for _, field := range fields {
+			t.AddColumnWithConfig(TableColumn{
+				Header:    field.header,
+				Alignment: field.alignment,
+			})
+		}
+	}
+
+	cells := make([]string, len(fields))
+	for i, field := range fields {
+		cells[i] = fmt.Sprint(value.Field(field.index).Interface())
+	}
+
+	return t.AddRow(cells...)
+}
+
+// TableFromStructs creates a table with one row per item, with columns taken from
+// the fields of T as described in AddRowStruct
+func TableFromStructs[T any](items []T) *Table {
+	table := NewTable()
+	for _, item := range items {
+		table.AddRowStruct(item)
+	}
+	return table
+}
+
+type structTableField struct {
+	index     int
+	header    string
+	alignment TableAlignment
+}
+
+// structTableFields lists the exported fields of typ that become table columns
+func structTableFields(typ reflect.Type) []structTableField {
+	var fields []structTableField
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("clime")
+		if tag == "-" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		column := structTableField{index: i, header: field.Name, alignment: AlignLeft}
+		if parts[0] != "" {
+			column.header = parts[0]
+		}
+
+		for _, option := range parts[1:] {
+			key, val, _ := strings.Cut(strings.TrimSpace(option), "=")
+			if key != "align" {
+				continue
+			}
+			switch val {
+			case "center":
+				column.alignment = AlignCenter
+			case "right":
+				column.alignment = AlignRight
+			}
+		}
+
+		fields = append(fields, column)
+	}
+
+	return fields
+}
+
 // SetColumnAlignment sets the alignment for a specific column
 func (t *Table) SetColumnAlignment(columnIndex int, alignment TableAlignment) *Table {
 	if columnIndex >= 0 && columnIndex < len(t.columns) {
@@ -262,6 +367,51 @@ func (t *Table) SetColumnAlignment(columnIndex int, alignment TableAlignment) *T
 	return t
 }
 
+// SetCellAlignment overrides the column alignment for a single cell of an added
+// row. Indices outside the current rows and columns are ignored
+func (t *Table) SetCellAlignment(row, col int, alignment TableAlignment) *Table {
+	if row < 0 || row >= len(t.rows) || col < 0 || col >= len(t.columns) {
+		return t
+	}
+	if t.cellAlignments == nil {
+		t.cellAlignments = make(map[[2]int]TableAlignment)
+	}
+	t.cellAlignments[[2]int{row, col}] = alignment
+	return t
+}
+
+// SetColumnMinWidth sets the narrowest content width a column is shrunk to when the table is too wide
+func (t *Table) SetColumnMinWidth(columnIndex int, width int) *Table {
+	if columnIndex >= 0 && columnIndex < len(t.columns) {
+		t.columns[columnIndex].MinWidth = width
+	}
+	return t
+}
+
+// SetColumnMaxWidth sets the widest content width an auto-sized column grows to
+func (t *Table) SetColumnMaxWidth(columnIndex int, width int) *Table {
+	if columnIndex >= 0 && columnIndex < len(t.columns) {
+		t.columns[columnIndex].MaxWidth = width
+	}
+	return t
+}
+
+// SetColumnFormatter sets a function that formats the column's cell values before
+// they are measured, aligned and colored
+func (t *Table) SetColumnFormatter(columnIndex int, formatter func(raw string) string) *Table {
+	if columnIndex >= 0 && columnIndex < len(t.columns) {
+		t.columns[columnIndex].Formatter = formatter
+	}
+	return t
+}
+
+// SetColumnAsStatus shows the column's status values, such as "true" or "fail", as
+// colored icons using DefaultStatusIcons. Use SetColumnFormatter with StatusFormatter
+// for a custom mapping
+func (t *Table) SetColumnAsStatus(columnIndex int) *Table {
+	return t.SetColumnFormatter(columnIndex, StatusFormatter(nil))
+}
+
 // SetColumnColor sets the color for a specific column
 func (t *Table) SetColumnColor(columnIndex int, color *Color) *Table {
 	if columnIndex >= 0 && columnIndex < len(t.columns) {
@@ -273,108 +423,567 @@ func (t *Table) SetColumnColor(columnIndex int, color *Color) *Table {
 // Clear clears all rows from the table
 func (t *Table) Clear() *Table {
 	t.rows = make([][]string, 0)
+	t.cellAlignments = nil
 	return t
 }
 
 // Render renders the table and returns the string representation
 func (t *Table) Render() string {
+	var result strings.Builder
+	t.RenderTo(&result)
+	return result.String()
+}
+
+// RenderContext renders the table like Render, checking ctx every
+// renderCheckInterval rows while sizing columns and rendering rows, and returns
+// ctx's error as soon as it is cancelled
+func (t *Table) RenderContext(ctx context.Context) (string, error) {
+	var result strings.Builder
+	if err := t.renderToContext(ctx, &result); err != nil {
+		return "", err
+	}
+	return result.String(), nil
+}
+
+// RenderTo writes the table to w line by line instead of building it in memory
+func (t *Table) RenderTo(w io.Writer) error {
+	return t.renderToContext(context.Background(), w)
+}
+
+// renderCheckInterval is how many rows RenderContext handles between checks of its context
+const renderCheckInterval = 1000
+
+// renderToContext writes the table to w, stopping when ctx is cancelled
+func (t *Table) renderToContext(ctx context.Context, w io.Writer) error {
 	if len(t.columns) == 0 {
-		return ""
+		return nil
+	}
+	if t.rowNumbers {
+		return t.numbered(0).renderToContext(ctx, w)
 	}
 
-	if t.useSmartSizing {
-		rm := GetResponsiveManager()
-		rm.RefreshBreakpoint()
-		t.calculateResponsiveSize()
+	if err := t.prepareLayout(ctx); err != nil {
+		return err
 	}
 
-	t.calculateColumnWidths()
+	if err := t.writeHead(w); err != nil {
+		return err
+	}
 
-	var result strings.Builder
+	for i, row := range t.rows {
+		if i%renderCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, t.renderDataRow(i, row)+"\n"); err != nil {
+			return err
+		}
+	}
+
+	return t.writeFoot(w)
+}
 
-	if t.showBorders {
-		result.WriteString(t.renderTopBorder())
-		result.WriteString("\n")
+// StreamRows writes the table to w with the rows received from rows, writing each
+// as it arrives and the bottom border once the channel is closed. Column widths
+// can't depend on rows that haven't arrived yet, so they come from the column
+// Width settings, the headers and any rows already added to the table, which are
+// written first. Cells wider than their column are cut according to the overflow mode.
+// With WithRowNumbers the "#" column reserves room for streamNumberDigits digits, or
+// as many as the rows already added need; longer row numbers are cut like other cells
+func (t *Table) StreamRows(w io.Writer, rows <-chan []string) error {
+	if len(t.columns) == 0 {
+		for range rows {
+		}
+		return nil
 	}
 
-	if t.showHeader {
-		result.WriteString(t.renderHeaderRow())
-		result.WriteString("\n")
+	view := t
+	if t.rowNumbers {
+		view = t.numbered(streamNumberDigits)
+	}
 
-		if t.showBorders {
-			result.WriteString(t.renderHeaderSeparator())
-			result.WriteString("\n")
+	if err := view.prepareLayout(context.Background()); err != nil {
+		return err
+	}
+
+	if err := view.writeHead(w); err != nil {
+		return err
+	}
+
+	for i, row := range view.rows {
+		if _, err := io.WriteString(w, view.renderDataRow(i, row)+"\n"); err != nil {
+			return err
 		}
 	}
 
+	index := len(view.rows)
+	for row := range rows {
+		if t.rowNumbers {
+			row = append([]string{strconv.Itoa(index + 1)}, row...)
+		}
+		if _, err := io.WriteString(w, view.renderDataRow(index, row)+"\n"); err != nil {
+			return err
+		}
+		index++
+	}
+
+	return view.writeFoot(w)
+}
+
+// streamNumberDigits is the number of digits StreamRows reserves for row numbers,
+// since the number of rows to come is unknown
+const streamNumberDigits = 4
+
+// numbered returns a copy of the table with the row number column added as its
+// first column and each row starting with its number. The column is at least
+// minDigits wide
+func (t *Table) numbered(minDigits int) *Table {
+	digits := max(len(strconv.Itoa(max(len(t.rows), 1))), minDigits)
+	view := *t
+	view.rowNumbers = false
+	view.columns = append([]TableColumn{{
+		Header:    "#",
+		Width:     digits,
+		Alignment: AlignRight,
+		MinWidth:  digits,
+	}}, t.columns...)
+
+	view.rows = make([][]string, len(t.rows))
 	for i, row := range t.rows {
-		result.WriteString(t.renderDataRow(row))
-		result.WriteString("\n")
+		view.rows[i] = append([]string{strconv.Itoa(i + 1)}, row...)
+	}
 
-		if t.showBorders && i < len(t.rows)-1 {
-			//@TODO: Add row separators
+	view.cellAlignments = make(map[[2]int]TableAlignment, len(t.cellAlignments))
+	for cell, alignment := range t.cellAlignments {
+		view.cellAlignments[[2]int{cell[0], cell[1] + 1}] = alignment
+	}
+	return &view
+}
+
+// writeHead writes the top border and header rows
+func (t *Table) writeHead(w io.Writer) error {
+	var head strings.Builder
+
+	if t.outerBorder {
+		head.WriteString(t.renderTopBorder())
+		head.WriteString("\n")
+	}
+
+	if t.showHeader {
+		head.WriteString(t.renderHeaderRow())
+		head.WriteString("\n")
+
+		if t.hasBorders() {
+			head.WriteString(t.renderHeaderSeparator())
+			head.WriteString("\n")
 		}
 	}
 
-	if t.showBorders {
-		result.WriteString(t.renderBottomBorder())
+	_, err := io.WriteString(w, head.String())
+	return err
+}
+
+// writeFoot writes the bottom border and the page info footer
+func (t *Table) writeFoot(w io.Writer) error {
+	var lines []string
+	if t.outerBorder {
+		lines = append(lines, t.renderBottomBorder())
+	}
+	if t.pageSize > 0 {
+		lines = append(lines, t.renderPageInfo())
 	}
 
-	return result.String()
+	_, err := io.WriteString(w, strings.Join(lines, "\n"))
+	return err
 }
 
 // Print renders and prints the table
 func (t *Table) Print() {
-	fmt.Print(t.Render())
+	t.RenderTo(outputWriter())
 }
 
 // Println renders and prints the table with a newline
 func (t *Table) Println() {
-	fmt.Println(t.Render())
+	fmt.Fprintln(outputWriter(), t.Render())
+}
+
+// PrintPaged prints the table one screen at a time on a TTY, repeating the header
+// at the top of each page and waiting for space/enter (next page) or q (quit)
+// between pages. On a non-TTY the whole table is printed at once
+func (t *Table) PrintPaged() {
+	if len(t.columns) == 0 {
+		return
+	}
+
+	if t.rowNumbers {
+		t.numbered(0).PrintPaged()
+		return
+	}
+
+	out := outputWriter()
+	terminal := GetTerminal()
+	if !isTerminalWriter(out) || !term.IsTerminal(int(os.Stdin.Fd())) {
+		t.Println()
+		return
+	}
+
+	if t.horizontalScroll {
+		t.browse(out)
+		return
+	}
+
+	t.prepareLayout(context.Background())
+
+	var head strings.Builder
+	t.writeHead(&head)
+	fmt.Fprint(out, head.String())
+
+	// Leave room for the header, the bottom border and the paging prompt. Rows are
+	// counted in screen lines, since wrapped cells and narrow terminals take several
+	width := terminal.Width()
+	headLines := 0
+	if head.Len() > 0 {
+		headLines = displayLines(strings.TrimSuffix(head.String(), "\n"), width)
+	}
+	pageSize := max(terminal.Height()-headLines-2, 1)
+
+	used := 0
+	for i, row := range t.rows {
+		rendered := t.renderDataRow(i, row)
+		lines := displayLines(rendered, width)
+		if used > 0 && used+lines > pageSize {
+			if !waitForNextPage() {
+				break
+			}
+			fmt.Fprint(out, head.String())
+			used = 0
+		}
+		fmt.Fprintln(out, rendered)
+		used += lines
+	}
+
+	if t.outerBorder {
+		fmt.Fprintln(out, t.renderBottomBorder())
+	}
+	if t.pageSize > 0 {
+		fmt.Fprintln(out, t.renderPageInfo())
+	}
+}
+
+// browse shows the table in the scrolling viewer enabled by WithHorizontalScroll
+func (t *Table) browse(out io.Writer) {
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		t.Println()
+		return
+	}
+	defer term.Restore(fd, oldState)
+
+	full := t.naturalLayout()
+
+	chrome := 1 // status line
+	if t.outerBorder {
+		chrome += 2
+	}
+	if t.showHeader {
+		chrome++
+		if t.hasBorders() {
+			chrome++
+		}
+	}
+	if t.pageSize > 0 {
+		chrome++
+	}
+
+	firstColumn, topRow, lines := 1, 0, 0
+	for {
+		terminal := GetTerminal()
+		terminal.Refresh()
+		width := terminal.Width()
+		pageSize := max(terminal.Height()-chrome, 1)
+		topRow = max(min(topRow, len(t.rows)-pageSize), 0)
+		lastRow := min(topRow+pageSize, len(t.rows))
+
+		columns := full.fitColumns(firstColumn, width)
+		status := fmt.Sprintf("columns %d-%d of %d · rows %d-%d of %d · ←/→ columns, ↑/↓/space rows, q quit",
+			min(firstColumn+1, len(t.columns)), columns[len(columns)-1]+1, len(t.columns),
+			min(topRow+1, lastRow), lastRow, len(t.rows))
+		output := full.window(columns, topRow, lastRow).Render() + "\n" + Muted.Sprint(TruncateString(status, width-1))
+
+		if lines > 1 {
+			moveCursorUpTo(out, lines-1)
+		}
+		fmt.Fprint(out, "\r\033[J"+strings.ReplaceAll(output, "\n", "\r\n"))
+		lines = displayLines(output, width)
+
+		key, err := readKey(context.Background())
+		if err != nil {
+			break
+		}
+
+		switch {
+		case key.Type == KeyLeft:
+			firstColumn = max(firstColumn-1, 1)
+		case key.Type == KeyRight:
+			if columns[len(columns)-1] < len(t.columns)-1 {
+				firstColumn++
+			}
+		case key.Type == KeyUp:
+			topRow--
+		case key.Type == KeyDown:
+			topRow++
+		case key.Type == KeyPageUp:
+			topRow -= pageSize
+		case key.Type == KeyPageDown, key.Type == KeyChar && key.Rune == ' ':
+			topRow += pageSize
+		case key.Type == KeyHome:
+			topRow = 0
+		case key.Type == KeyEnd:
+			topRow = len(t.rows)
+		case key.Type == KeyEnter, key.Type == KeyEsc, key.Type == KeyCtrlC, key.Type == KeyChar && (key.Rune == 'q' || key.Rune == 'Q'):
+			// Leave the table on screen without the status line
+			fmt.Fprint(out, "\r\033[2K")
+			return
+		}
+		topRow = max(topRow, 0)
+	}
+
+	fmt.Fprint(out, "\r\n")
+}
+
+// naturalLayout returns a copy of the table laid out at its natural width, for the
+// viewer to pick the columns that fit. Percentage columns have no natural width,
+// so they are sized to their content
+func (t *Table) naturalLayout() *Table {
+	full := *t
+	full.useSmartSizing = false
+	full.maxWidth = math.MaxInt32
+	full.columns = make([]TableColumn, len(t.columns))
+	for i, column := range t.columns {
+		column.Percent = 0
+		full.columns[i] = column
+	}
+	full.prepareLayout(context.Background())
+	return &full
+}
+
+// fitColumns returns the indexes of the columns to show when scrolled to
+// firstColumn: the pinned first column followed by as many columns from
+// firstColumn as fit within width, always at least one. t must be laid out
+func (t *Table) fitColumns(firstColumn, width int) []int {
+	separator, edges := 0, 0
+	if t.columnSeparators {
+		separator = 1
+	}
+	if t.outerBorder {
+		edges = 2
+	}
+
+	columns := []int{0}
+	used := edges + t.widths[0]
+	for i := firstColumn; i < len(t.widths); i++ {
+		if len(columns) > 1 && used+separator+t.widths[i] > width {
+			break
+		}
+		columns = append(columns, i)
+		used += separator + t.widths[i]
+	}
+	return columns
+}
+
+// window returns a copy of the laid-out table holding only the given columns,
+// at their current widths, and the rows from first up to last
+func (t *Table) window(columns []int, first, last int) *Table {
+	view := *t
+	view.widths = nil
+
+	view.columns = make([]TableColumn, len(columns))
+	position := make(map[int]int, len(columns))
+	for i, index := range columns {
+		column := t.columns[index]
+		column.Width = t.widths[index] - t.padding*2
+		column.Percent = 0
+		view.columns[i] = column
+		position[index] = i
+	}
+
+	view.rows = make([][]string, 0, last-first)
+	for _, row := range t.rows[first:last] {
+		cells := make([]string, len(columns))
+		for i, index := range columns {
+			if index < len(row) {
+				cells[i] = row[index]
+			}
+		}
+		view.rows = append(view.rows, cells)
+	}
+
+	view.cellAlignments = make(map[[2]int]TableAlignment)
+	for cell, alignment := range t.cellAlignments {
+		if i, ok := position[cell[1]]; ok && cell[0] >= first && cell[0] < last {
+			view.cellAlignments[[2]int{cell[0] - first, i}] = alignment
+		}
+	}
+	return &view
+}
+
+// waitForNextPage shows a paging prompt and reports whether the next page was requested
+func waitForNextPage() bool {
+	fmt.Fprint(outputWriter(), Muted.Sprint("-- More -- (space/enter next, q quit)"))
+	defer ClearLine()
+
+	oldState, err := term.MakeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		return true
+	}
+	defer term.Restore(int(os.Stdin.Fd()), oldState)
+
+	for {
+		key, err := readKey(context.Background())
+		if err != nil {
+			return false
+		}
+
+		switch {
+		case key.Type == KeyEnter, key.Type == KeyChar && key.Rune == ' ':
+			return true
+		case key.Type == KeyEsc, key.Type == KeyCtrlC, key.Type == KeyChar && (key.Rune == 'q' || key.Rune == 'Q'):
+			return false
+		}
+	}
+}
+
+// FitsTerminal reports whether the rendered table fits the terminal width
+// and by how many columns it overflows when it does not
+func (t *Table) FitsTerminal() (bool, int) {
+	return measureOverflow(t.Render())
+}
+
+// prepareLayout refreshes responsive sizing and computes the column widths used for
+// rendering, returning ctx's error if it is cancelled meanwhile
+func (t *Table) prepareLayout(ctx context.Context) error {
+	if t.useSmartSizing {
+		rm := GetResponsiveManager()
+		rm.RefreshBreakpoint()
+		t.calculateResponsiveSize()
+	}
+
+	return t.calculateColumnWidths(ctx)
 }
 
 // calculateColumnWidths calculates optimal column widths
-func (t *Table) calculateColumnWidths() {
+func (t *Table) calculateColumnWidths(ctx context.Context) error {
+	t.widths = make([]int, len(t.columns))
+	for i, column := range t.columns {
+		t.widths[i] = column.Width
+	}
+
+	t.applyPercentWidths()
+
 	if !t.autoResize {
-		return
+		return nil
 	}
 
 	for i, column := range t.columns {
-		if column.Width == 0 {
-			t.columns[i].Width = getVisualWidth(column.Header)
+		if column.Width == 0 && column.Percent <= 0 {
+			t.widths[i] = getVisualWidth(column.Header)
 		}
 	}
 
-	for _, row := range t.rows {
+	for r, row := range t.rows {
+		if r%renderCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
 		for i, cell := range row {
-			if i < len(t.columns) && getVisualWidth(cell) > t.columns[i].Width {
-				t.columns[i].Width = getVisualWidth(cell)
+			if i >= len(t.columns) || t.columns[i].Percent > 0 {
+				continue
+			}
+			if width := getVisualWidth(t.formatValue(i, cell)); width > t.widths[i] {
+				t.widths[i] = width
 			}
 		}
 	}
 
-	for i := range t.columns {
-		t.columns[i].Width += t.padding * 2
+	for i, column := range t.columns {
+		if column.Width == 0 && column.MaxWidth > 0 && t.widths[i] > column.MaxWidth {
+			t.widths[i] = column.MaxWidth
+		}
+	}
+
+	for i, column := range t.columns {
+		if column.Percent <= 0 {
+			t.widths[i] += t.padding * 2
+		}
 	}
 
 	totalWidth := t.calculateTotalWidth()
 	if totalWidth > t.maxWidth {
 		t.adjustColumnWidths(totalWidth)
 	}
+	return nil
+}
+
+// applyPercentWidths sizes percentage columns as a share of the available table width,
+// scaling them down proportionally when their percentages sum to more than 1
+func (t *Table) applyPercentWidths() {
+	totalPercent := 0.0
+	for _, column := range t.columns {
+		if column.Percent > 0 {
+			totalPercent += column.Percent
+		}
+	}
+
+	if totalPercent == 0 {
+		return
+	}
+
+	scale := 1.0
+	if totalPercent > 1.0 {
+		scale = 1.0 / totalPercent
+	}
+
+	availableWidth := t.maxWidth - t.bordersWidth()
+
+	for i, column := range t.columns {
+		if column.Percent > 0 {
+			width := int(column.Percent * scale * float64(availableWidth))
+			if width < 3 {
+				width = 3
+			}
+			t.widths[i] = width
+		}
+	}
 }
 
 // calculateTotalWidth calculates the total table width
 func (t *Table) calculateTotalWidth() int {
 	totalWidth := 0
-	for _, column := range t.columns {
-		totalWidth += column.Width
+	for _, width := range t.widths {
+		totalWidth += width
 	}
 
-	if t.showBorders {
-		totalWidth += len(t.columns) + 1
-	}
+	return totalWidth + t.bordersWidth()
+}
 
-	return totalWidth
+// hasBorders reports whether any border is drawn, which also draws the header separator
+func (t *Table) hasBorders() bool {
+	return t.outerBorder || t.columnSeparators
+}
+
+// bordersWidth returns the width taken by the vertical borders of a row
+func (t *Table) bordersWidth() int {
+	width := 0
+	if t.outerBorder {
+		width += 2
+	}
+	if t.columnSeparators && len(t.columns) > 1 {
+		width += len(t.columns) - 1
+	}
+	return width
 }
 
 // calculateResponsiveSize calculates responsive table size
@@ -391,100 +1000,126 @@ func (t *Table) calculateResponsiveSize() {
 			}
 			if config.Compact {
 				t.padding = min(t.padding, 1)
-				t.showBorders = false
+				t.outerBorder = false
+				t.columnSeparators = false
 			}
 			return
 		}
 	}
 
 	if t.useSmartSizing {
-		t.maxWidth = SmartWidth(0.95)
+		t.maxWidth = SmartWidth(t.smartRatio)
 		t.padding = SmartPadding()
 	}
 }
 
-// adjustColumnWidths adjusts column widths to fit within maxWidth
+// adjustColumnWidths shrinks the non-percentage columns to fit within maxWidth. Each
+// column gives up width in proportion to its slack above its minimum width
 func (t *Table) adjustColumnWidths(totalWidth int) {
 	if len(t.columns) == 0 {
 		return
 	}
 
-	excess := totalWidth - t.maxWidth
-	perColumn := excess / len(t.columns)
-
-	for i := range t.columns {
-		t.columns[i].Width -= perColumn
-		if t.columns[i].Width < 3 {
-			t.columns[i].Width = 3
+	floors := make([]int, len(t.columns))
+	slack := make([]int, len(t.columns))
+	totalSlack := 0
+	for i, column := range t.columns {
+		if column.Percent > 0 {
+			continue
+		}
+		floors[i] = 3
+		if column.MinWidth > 0 {
+			floors[i] = max(column.MinWidth+t.padding*2, floors[i])
 		}
+		slack[i] = max(t.widths[i]-floors[i], 0)
+		totalSlack += slack[i]
 	}
-}
 
-// renderTopBorder renders the top border of the table
-func (t *Table) renderTopBorder() string {
-	if len(t.columns) == 0 {
-		return ""
+	if totalSlack == 0 {
+		return
 	}
 
-	var border strings.Builder
-	border.WriteString(t.style.TopLeft)
+	excess := min(totalWidth-t.maxWidth, totalSlack)
+	removed := 0
+	for i := range t.columns {
+		cut := excess * slack[i] / totalSlack
+		t.widths[i] -= cut
+		slack[i] -= cut
+		removed += cut
+	}
 
-	for i, column := range t.columns {
-		border.WriteString(strings.Repeat(t.style.Horizontal, column.Width))
-		if i < len(t.columns)-1 {
-			border.WriteString(t.style.TopTee)
+	// Hand out what integer division left over, one column at a time
+	for i := 0; removed < excess; i = (i + 1) % len(t.columns) {
+		if slack[i] > 0 {
+			t.widths[i]--
+			slack[i]--
+			removed++
 		}
 	}
+}
 
-	border.WriteString(t.style.TopRight)
-
-	if t.borderColor != nil {
-		return t.borderColor.Sprint(border.String())
-	}
-	return border.String()
+// renderTopBorder renders the top border of the table
+func (t *Table) renderTopBorder() string {
+	return t.renderRule(t.style.TopLeft, t.style.TopTee, t.style.TopRight)
 }
 
 // renderBottomBorder renders the bottom border of the table
 func (t *Table) renderBottomBorder() string {
-	if len(t.columns) == 0 {
-		return ""
-	}
+	return t.renderRule(t.style.BottomLeft, t.style.BottomTee, t.style.BottomRight)
+}
 
-	var border strings.Builder
-	border.WriteString(t.style.BottomLeft)
+// renderPageInfo renders the footer set by WithPageInfo, leaving out the item range
+// when it doesn't fit within the table width
+func (t *Table) renderPageInfo() string {
+	pages := max((t.pageTotal+t.pageSize-1)/t.pageSize, 1)
+	first := (t.page-1)*t.pageSize + 1
+	last := min(t.page*t.pageSize, t.pageTotal)
 
-	for i, column := range t.columns {
-		border.WriteString(strings.Repeat(t.style.Horizontal, column.Width))
-		if i < len(t.columns)-1 {
-			border.WriteString(t.style.BottomTee)
-		}
+	showing := fmt.Sprintf("showing %d–%d of %d", first, last, t.pageTotal)
+	if first < 1 || first > last {
+		showing = fmt.Sprintf("showing 0 of %d", t.pageTotal)
 	}
 
-	border.WriteString(t.style.BottomRight)
+	separator := " · "
+	width := t.calculateTotalWidth()
+	pageText := TruncateString(fmt.Sprintf("Page %d/%d", t.page, pages), width)
+	if getVisualWidth(pageText+separator+showing) > width {
+		return Muted.Sprint(pageText)
+	}
 
 	if t.borderColor != nil {
-		return t.borderColor.Sprint(border.String())
+		separator = t.borderColor.Sprint(separator)
 	}
-	return border.String()
+	return Muted.Sprint(pageText) + separator + Muted.Sprint(showing)
 }
 
 // renderHeaderSeparator renders the separator between header and data
 func (t *Table) renderHeaderSeparator() string {
+	return t.renderRule(t.style.LeftTee, t.style.Cross, t.style.RightTee)
+}
+
+// renderRule renders a horizontal border line, with the left and right ends drawn
+// for the outer border and the junctions for the column separators
+func (t *Table) renderRule(left, junction, right string) string {
 	if len(t.columns) == 0 {
 		return ""
 	}
 
 	var border strings.Builder
-	border.WriteString(t.style.LeftTee)
+	if t.outerBorder {
+		border.WriteString(left)
+	}
 
-	for i, column := range t.columns {
-		border.WriteString(strings.Repeat(t.style.Horizontal, column.Width))
-		if i < len(t.columns)-1 {
-			border.WriteString(t.style.Cross)
+	for i, width := range t.widths {
+		border.WriteString(strings.Repeat(t.style.Horizontal, width))
+		if i < len(t.widths)-1 && t.columnSeparators {
+			border.WriteString(junction)
 		}
 	}
 
-	border.WriteString(t.style.RightTee)
+	if t.outerBorder {
+		border.WriteString(right)
+	}
 
 	if t.borderColor != nil {
 		return t.borderColor.Sprint(border.String())
@@ -492,77 +1127,124 @@ func (t *Table) renderHeaderSeparator() string {
 	return border.String()
 }
 
+// vertical returns the vertical border character in the border color
+func (t *Table) vertical() string {
+	if t.borderColor != nil {
+		return t.borderColor.Sprint(t.style.Vertical)
+	}
+	return t.style.Vertical
+}
+
 // renderHeaderRow renders the header row
 func (t *Table) renderHeaderRow() string {
 	var row strings.Builder
 
-	if t.showBorders {
-		if t.borderColor != nil {
-			row.WriteString(t.borderColor.Sprint(t.style.Vertical))
-		} else {
-			row.WriteString(t.style.Vertical)
-		}
+	if t.outerBorder {
+		row.WriteString(t.vertical())
 	}
 
-	for _, column := range t.columns {
-		cell := t.formatCell(column.Header, column.Width, column.Alignment)
+	for i, column := range t.columns {
+		cell := t.formatCell(column.Header, t.widths[i], column.Alignment)
 		if t.headerColor != nil {
 			cell = t.headerColor.Sprint(cell)
 		}
 		row.WriteString(cell)
 
-		if t.showBorders {
-			if t.borderColor != nil {
-				row.WriteString(t.borderColor.Sprint(t.style.Vertical))
-			} else {
-				row.WriteString(t.style.Vertical)
-			}
+		if i < len(t.columns)-1 && t.columnSeparators {
+			row.WriteString(t.vertical())
 		}
 	}
 
+	if t.outerBorder {
+		row.WriteString(t.vertical())
+	}
+
 	return row.String()
 }
 
-// renderDataRow renders a data row
-func (t *Table) renderDataRow(rowData []string) string {
-	var row strings.Builder
+// renderDataRow renders a data row, spanning several lines when cells wrap
+func (t *Table) renderDataRow(rowIndex int, rowData []string) string {
+	cellLines := make([][]string, len(t.columns))
+	height := 1
 
-	if t.showBorders {
-		if t.borderColor != nil {
-			row.WriteString(t.borderColor.Sprint(t.style.Vertical))
+	for i := range t.columns {
+		cellData := ""
+		if i < len(rowData) {
+			cellData = t.formatValue(i, rowData[i])
+		}
+
+		if t.overflow == OverflowWrap {
+			cellLines[i] = wrapCell(cellData, t.widths[i]-t.padding*2)
 		} else {
-			row.WriteString(t.style.Vertical)
+			cellLines[i] = []string{cellData}
+		}
+
+		if len(cellLines[i]) > height {
+			height = len(cellLines[i])
 		}
 	}
 
+	lines := make([]string, height)
+	for lineIndex := range lines {
+		lines[lineIndex] = t.renderDataLine(rowIndex, cellLines, lineIndex)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// formatValue applies the column's formatter, if any, to a raw cell value
+func (t *Table) formatValue(columnIndex int, raw string) string {
+	if formatter := t.columns[columnIndex].Formatter; formatter != nil {
+		return formatter(raw)
+	}
+	return raw
+}
+
+// renderDataLine renders a single physical line of the data row at rowIndex
+func (t *Table) renderDataLine(rowIndex int, cellLines [][]string, lineIndex int) string {
+	var row strings.Builder
+
+	if t.outerBorder {
+		row.WriteString(t.vertical())
+	}
+
 	for i, column := range t.columns {
 		cellData := ""
-		if i < len(rowData) {
-			cellData = rowData[i]
+		if lineIndex < len(cellLines[i]) {
+			cellData = cellLines[i][lineIndex]
 		}
 
-		cell := t.formatCell(cellData, column.Width, column.Alignment)
+		alignment := column.Alignment
+		if cellAlignment, ok := t.cellAlignments[[2]int{rowIndex, i}]; ok {
+			alignment = cellAlignment
+		}
+
+		cell := t.formatCell(cellData, t.widths[i], alignment)
 		if column.Color != nil {
 			cell = column.Color.Sprint(cell)
 		}
 		row.WriteString(cell)
 
-		if t.showBorders {
-			if t.borderColor != nil {
-				row.WriteString(t.borderColor.Sprint(t.style.Vertical))
-			} else {
-				row.WriteString(t.style.Vertical)
-			}
+		if i < len(t.columns)-1 && t.columnSeparators {
+			row.WriteString(t.vertical())
 		}
 	}
 
+	if t.outerBorder {
+		row.WriteString(t.vertical())
+	}
+
 	return row.String()
 }
 
 // formatCell formats a cell with proper alignment and padding
 func (t *Table) formatCell(content string, width int, alignment TableAlignment) string {
 	if getVisualWidth(content) > width-t.padding*2 {
-		content = TruncateString(content, width-t.padding*2)
+		if t.overflow == OverflowEllipsisMiddle {
+			content = truncateMiddle(content, width-t.padding*2)
+		} else {
+			content = TruncateString(content, width-t.padding*2)
+		}
 	}
 
 	contentWidth := getVisualWidth(content)
@@ -582,6 +1264,133 @@ func (t *Table) formatCell(content string, width int, alignment TableAlignment)
 	return strings.Repeat(" ", leftPadding) + content + strings.Repeat(" ", rightPadding)
 }
 
+// wrapCell wraps cell content to the column width
+func wrapCell(content string, width int) []string {
+	if width <= 0 || getVisualWidth(content) <= width {
+		return []string{content}
+	}
+
+	return wrapText(content, width)
+}
+
+// CurrencyFormatter formats numeric cells as amounts with two decimals, thousands
+// separators and the given symbol, e.g. "1234.5" as "$1,234.50". Other values are kept as is
+func CurrencyFormatter(symbol string) func(string) string {
+	return func(raw string) string {
+		value, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return raw
+		}
+
+		sign := ""
+		if value < 0 {
+			sign = "-"
+			value = -value
+		}
+		return sign + symbol + groupThousands(strconv.FormatFloat(value, 'f', 2, 64))
+	}
+}
+
+// ThousandsFormatter formats numeric cells with comma thousands separators, keeping
+// their decimals, e.g. "1234567.8" as "1,234,567.8". Other values are kept as is
+func ThousandsFormatter() func(string) string {
+	return func(raw string) string {
+		trimmed := strings.TrimSpace(raw)
+		if _, err := strconv.ParseFloat(trimmed, 64); err != nil {
+			return raw
+		}
+
+		sign := ""
+		if strings.HasPrefix(trimmed, "-") || strings.HasPrefix(trimmed, "+") {
+			sign, trimmed = trimmed[:1], trimmed[1:]
+			if sign == "+" {
+				sign = ""
+			}
+		}
+		return sign + groupThousands(trimmed)
+	}
+}
+
+// PercentFormatter formats fractional cells (0-1) as percentages with the given
+// number of decimals, e.g. "0.256" as "25.6%" with one decimal. Other values are kept as is
+func PercentFormatter(decimals int) func(string) string {
+	return func(raw string) string {
+		value, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return raw
+		}
+		return strconv.FormatFloat(value*100, 'f', max(decimals, 0), 64) + "%"
+	}
+}
+
+// StatusIcon is the icon, and its color, shown for a status cell value
+type StatusIcon struct {
+	Icon  string
+	Color *Color
+}
+
+// StatusIconMap maps status cell values to their icons. Values are matched
+// ignoring case and surrounding spaces
+type StatusIconMap map[string]StatusIcon
+
+// DefaultStatusIcons maps common truthy values to a green ✓, falsy and failed
+// values to a red ✗, and warnings to a yellow ⚠
+var DefaultStatusIcons = StatusIconMap{
+	"true": {"✓", Success}, "yes": {"✓", Success}, "ok": {"✓", Success},
+	"pass": {"✓", Success}, "passed": {"✓", Success}, "success": {"✓", Success},
+	"enabled": {"✓", Success}, "on": {"✓", Success}, "up": {"✓", Success},
+	"false": {"✗", Error}, "no": {"✗", Error}, "fail": {"✗", Error},
+	"failed": {"✗", Error}, "error": {"✗", Error},
+	"disabled": {"✗", Error}, "off": {"✗", Error}, "down": {"✗", Error},
+	"warn": {"⚠", Warning}, "warning": {"⚠", Warning},
+}
+
+// StatusFormatter shows cells found in icons as their colored icon, e.g. "true" as
+// a green ✓. A nil map uses DefaultStatusIcons. Other values are kept as is
+func StatusFormatter(icons StatusIconMap) func(string) string {
+	if icons == nil {
+		icons = DefaultStatusIcons
+	}
+
+	lookup := make(StatusIconMap, len(icons))
+	for value, icon := range icons {
+		lookup[strings.ToLower(strings.TrimSpace(value))] = icon
+	}
+
+	return func(raw string) string {
+		icon, ok := lookup[strings.ToLower(strings.TrimSpace(raw))]
+		if !ok {
+			return raw
+		}
+		if icon.Color != nil {
+			return icon.Color.Sprint(icon.Icon)
+		}
+		return icon.Icon
+	}
+}
+
+// groupThousands inserts commas between groups of three digits in the integer part
+// of an unsigned decimal number
+func groupThousands(number string) string {
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+	if len(integer) <= 3 || strings.IndexFunc(integer, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return number
+	}
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	if hasFraction {
+		return grouped.String() + "." + fraction
+	}
+	return grouped.String()
+}
+
 // SimpleTable creates a simple table from headers and rows
 func SimpleTable(headers []string, rows [][]string) string {
 	table := NewTable()
@@ -597,24 +1406,81 @@ func SimpleTable(headers []string, rows [][]string) string {
 
 // PrintSimpleTable prints a simple table
 func PrintSimpleTable(headers []string, rows [][]string) {
-	fmt.Print(SimpleTable(headers, rows))
+	fmt.Fprint(outputWriter(), SimpleTable(headers, rows))
+}
+
+// KeyValue is one row of an OrderedKeyValueTable
+type KeyValue struct {
+	Key   string
+	Value string
 }
 
-// KeyValueTable creates a two-column key-value table
+// KeyValueTable creates a two-column key-value table with the keys sorted, since
+// map iteration order is random. Use OrderedKeyValueTable to choose the order
 func KeyValueTable(data map[string]string) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]KeyValue, len(keys))
+	for i, key := range keys {
+		pairs[i] = KeyValue{Key: key, Value: data[key]}
+	}
+
+	return OrderedKeyValueTable(pairs)
+}
+
+// PrintKeyValueTable prints a key-value table
+func PrintKeyValueTable(data map[string]string) {
+	fmt.Fprint(outputWriter(), KeyValueTable(data))
+}
+
+// OrderedKeyValueTable creates a two-column key-value table with the rows in the given order
+func OrderedKeyValueTable(pairs []KeyValue) string {
 	table := NewTable().
 		AddColumn("Key").
 		AddColumn("Value").
 		SetColumnColor(0, BoldColor)
 
-	for key, value := range data {
-		table.AddRow(key, value)
+	for _, pair := range pairs {
+		table.AddRow(pair.Key, pair.Value)
 	}
 
 	return table.Render()
 }
 
-// PrintKeyValueTable prints a key-value table
-func PrintKeyValueTable(data map[string]string) {
-	fmt.Print(KeyValueTable(data))
+// PrintOrderedKeyValueTable prints an ordered key-value table
+func PrintOrderedKeyValueTable(pairs []KeyValue) {
+	fmt.Fprint(outputWriter(), OrderedKeyValueTable(pairs))
+}
+
+// StatusList renders pairs as a borderless "key: value" list in the given order.
+// Keys are bold and padded to the widest key so the colons and values line up;
+// values spanning several lines continue under the value column
+func StatusList(pairs [][2]string) string {
+	keyWidth := 0
+	for _, pair := range pairs {
+		keyWidth = max(keyWidth, getVisualWidth(pair[0]))
+	}
+	indent := strings.Repeat(" ", keyWidth+2)
+
+	var result strings.Builder
+	for i, pair := range pairs {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+
+		padding := strings.Repeat(" ", keyWidth-getVisualWidth(pair[0]))
+		result.WriteString(BoldColor.Sprint(pair[0]) + padding + ": ")
+		result.WriteString(strings.ReplaceAll(pair[1], "\n", "\n"+indent))
+	}
+
+	return result.String()
+}
+
+// PrintStatusList prints a status list
+func PrintStatusList(pairs [][2]string) {
+	fmt.Fprintln(outputWriter(), StatusList(pairs))
 }
@@ -0,0 +1,74 @@
+package clime
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestNaturalLayoutPercentColumns(t *testing.T) {
+	table := NewTable().
+		AddColumn("Name").
+		AddColumnWithPercent("Description", 0.5).
+		AddColumnWithPercent("Notes", 0.3).
+		AddRow("a", "short", "text")
+
+	full := table.naturalLayout()
+	for i, width := range full.widths {
+		if width > 100 {
+			t.Errorf("column %d width = %d, want it sized to its content", i, width)
+		}
+	}
+
+	if columns := full.fitColumns(1, 80); len(columns) != 3 {
+		t.Errorf("fitColumns = %v, want all three columns", columns)
+	}
+}
+
+func TestStreamRowsNumbersBeyondExistingRows(t *testing.T) {
+	table := NewTable().AddColumn("Name").WithRowNumbers(true).WithPadding(1)
+
+	rows := make(chan []string)
+	go func() {
+		for range 12 {
+			rows <- []string{"row"}
+		}
+		close(rows)
+	}()
+
+	var out strings.Builder
+	if err := table.StreamRows(&out, rows); err != nil {
+		t.Fatalf("StreamRows = %v", err)
+	}
+
+	lines := strings.Split(removeANSIEscapeCodes(out.String()), "\n")
+	for i := 1; i <= 12; i++ {
+		if !strings.Contains(lines[i+2], " "+strconv.Itoa(i)+" ") {
+			t.Errorf("row %d rendered as %q", i, lines[i+2])
+		}
+	}
+}
+
+func TestKeyValueTableStableOutput(t *testing.T) {
+	data := map[string]string{
+		"version": "1.0.0",
+		"arch":    "amd64",
+		"status":  "running",
+		"uptime":  "3d 4h",
+		"region":  "eu-west-1",
+	}
+
+	want := OrderedKeyValueTable([]KeyValue{
+		{"arch", "amd64"},
+		{"region", "eu-west-1"},
+		{"status", "running"},
+		{"uptime", "3d 4h"},
+		{"version", "1.0.0"},
+	})
+
+	for range 20 {
+		if got := KeyValueTable(data); got != want {
+			t.Fatalf("KeyValueTable =\n%s\nwant\n%s", got, want)
+		}
+	}
+}
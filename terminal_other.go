@@ -0,0 +1,8 @@
+//go:build !windows
+
+package clime
+
+// getConsoleSize reports 0x0 because term.GetSize already covers this platform
+func getConsoleSize() (width, height int) {
+	return 0, 0
+}
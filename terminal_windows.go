@@ -0,0 +1,38 @@
+//go:build windows
+
+package clime
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+// getConsoleSize gets the visible console window size with GetConsoleScreenBufferInfo,
+// trying stdout, stderr and then the process console
+func getConsoleSize() (width, height int) {
+	handles := []windows.Handle{windows.Stdout, windows.Stderr}
+
+	conout, err := windows.UTF16PtrFromString("CONOUT$")
+	if err == nil {
+		h, err := windows.CreateFile(conout, windows.GENERIC_READ|windows.GENERIC_WRITE,
+			windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+		if err == nil {
+			defer windows.CloseHandle(h)
+			handles = append(handles, h)
+		}
+	}
+
+	for _, h := range handles {
+		var info windows.ConsoleScreenBufferInfo
+		if err := windows.GetConsoleScreenBufferInfo(h, &info); err != nil {
+			continue
+		}
+
+		width = int(info.Window.Right-info.Window.Left) + 1
+		height = int(info.Window.Bottom-info.Window.Top) + 1
+		if width > 0 && height > 0 {
+			return width, height
+		}
+	}
+
+	return 0, 0
+}
@@ -1,6 +1,12 @@
 package clime
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
 
 type Theme struct {
 	Name       string
@@ -88,6 +94,7 @@ var (
 	}
 )
 
+// availableThemes holds the themes by lower-case name, guarded by themesMu
 var availableThemes = map[string]*Theme{
 	"dark":     DarkTheme,
 	"light":    LightTheme,
@@ -96,15 +103,26 @@ var availableThemes = map[string]*Theme{
 	"ocean":    OceanTheme,
 }
 
+var themesMu sync.RWMutex
+
 var currentTheme = DarkTheme
 
-// SetTheme sets the active theme by name
+// Component defaults that follow the active theme. NewBox, NewTable and the charts
+// read these when they are constructed, so set a theme before building components
+var (
+	DefaultBorderColor = DimColor
+	DefaultTitleColor  = BoldColor
+	DefaultChartColors = []*Color{BlueColor, GreenColor, YellowColor, RedColor, MagentaColor, CyanColor}
+)
+
+// SetTheme sets the active theme by name, ignoring case
 func SetTheme(themeName string) error {
-	theme, exists := availableThemes[themeName]
+	theme, exists := lookupTheme(themeName)
 	if !exists {
 		return fmt.Errorf("theme '%s' not found", themeName)
 	}
 
+	theme = theme.withFallback(DarkTheme)
 	currentTheme = theme
 
 	Success = theme.Success
@@ -113,9 +131,110 @@ func SetTheme(themeName string) error {
 	Info = theme.Info
 	Muted = theme.Muted
 
+	DefaultBorderColor = theme.Border
+	DefaultTitleColor = &Color{code: Bold + theme.Text.code, disabled: theme.Text.disabled}
+	DefaultChartColors = []*Color{theme.Primary, theme.Success, theme.Warning, theme.Error, theme.Secondary, theme.Info}
+
 	return nil
 }
 
+// RegisterTheme adds a theme under the given name so it can be selected with SetTheme.
+// Names are not case-sensitive; colors the theme leaves nil are taken from DarkTheme
+func RegisterTheme(name string, theme *Theme) {
+	themesMu.Lock()
+	defer themesMu.Unlock()
+	availableThemes[strings.ToLower(name)] = theme
+}
+
+// withFallback returns the theme with colors it leaves nil taken from fallback,
+// or the theme itself when it sets them all
+func (t *Theme) withFallback(fallback *Theme) *Theme {
+	if t == nil {
+		return fallback
+	}
+
+	filled := *t
+	colors := []struct {
+		color    **Color
+		fallback *Color
+	}{
+		{&filled.Primary, fallback.Primary},
+		{&filled.Secondary, fallback.Secondary},
+		{&filled.Success, fallback.Success},
+		{&filled.Warning, fallback.Warning},
+		{&filled.Error, fallback.Error},
+		{&filled.Info, fallback.Info},
+		{&filled.Muted, fallback.Muted},
+		{&filled.Background, fallback.Background},
+		{&filled.Text, fallback.Text},
+		{&filled.Border, fallback.Border},
+	}
+
+	changed := false
+	for _, c := range colors {
+		if *c.color == nil {
+			*c.color = c.fallback
+			changed = true
+		}
+	}
+	if !changed {
+		return t
+	}
+	return &filled
+}
+
+// lookupTheme finds a registered theme by name, ignoring case
+func lookupTheme(name string) (*Theme, bool) {
+	themesMu.RLock()
+	defer themesMu.RUnlock()
+	theme, exists := availableThemes[strings.ToLower(name)]
+	return theme, exists
+}
+
+// LoadThemeFromJSON reads a theme definition whose colors are hex strings, e.g.
+// {"name": "Brand", "primary": "#00A0FF"}. Colors left out fall back to DarkTheme
+func LoadThemeFromJSON(r io.Reader) (*Theme, error) {
+	var def map[string]string
+	if err := json.NewDecoder(r).Decode(&def); err != nil {
+		return nil, fmt.Errorf("failed to parse theme: %w", err)
+	}
+
+	theme := *DarkTheme
+	theme.Name = "Custom"
+	if name, ok := def["name"]; ok {
+		theme.Name = name
+	}
+
+	fields := []struct {
+		key   string
+		color **Color
+	}{
+		{"primary", &theme.Primary},
+		{"secondary", &theme.Secondary},
+		{"success", &theme.Success},
+		{"warning", &theme.Warning},
+		{"error", &theme.Error},
+		{"info", &theme.Info},
+		{"muted", &theme.Muted},
+		{"background", &theme.Background},
+		{"text", &theme.Text},
+		{"border", &theme.Border},
+	}
+
+	for _, field := range fields {
+		value, ok := def[field.key]
+		if !ok {
+			continue
+		}
+		if _, _, _, valid := parseHex(value); !valid {
+			return nil, fmt.Errorf("invalid hex color %q for field '%s'", value, field.key)
+		}
+		*field.color = Hex(value)
+	}
+
+	return &theme, nil
+}
+
 // GetTheme returns the current active theme
 func GetTheme() *Theme {
 	return currentTheme
@@ -123,6 +242,9 @@ func GetTheme() *Theme {
 
 // GetAvailableThemes returns a list of available theme names
 func GetAvailableThemes() []string {
+	themesMu.RLock()
+	defer themesMu.RUnlock()
+
 	names := make([]string, 0, len(availableThemes))
 	for name := range availableThemes {
 		names = append(names, name)
@@ -132,34 +254,36 @@ func GetAvailableThemes() []string {
 
 // ThemePreview shows a preview of a theme
 func ThemePreview(themeName string) error {
-	theme, exists := availableThemes[themeName]
+	theme, exists := lookupTheme(themeName)
 	if !exists {
 		return fmt.Errorf("theme '%s' not found", themeName)
 	}
+	theme = theme.withFallback(DarkTheme)
 
-	fmt.Printf("Theme: %s\n", BoldColor.Sprint(theme.Name))
-	fmt.Printf("Primary:  %s\n", theme.Primary.Sprint("Sample Text"))
-	fmt.Printf("Secondary: %s\n", theme.Secondary.Sprint("Sample Text"))
-	fmt.Printf("Success:  %s\n", theme.Success.Sprint("Sample Text"))
-	fmt.Printf("Warning:  %s\n", theme.Warning.Sprint("Sample Text"))
-	fmt.Printf("Error:    %s\n", theme.Error.Sprint("Sample Text"))
-	fmt.Printf("Info:     %s\n", theme.Info.Sprint("Sample Text"))
-	fmt.Printf("Muted:    %s\n", theme.Muted.Sprint("Sample Text"))
-	fmt.Printf("Background: %s\n", theme.Background.Sprint("Sample Text"))
-	fmt.Printf("Text:      %s\n", theme.Text.Sprint("Sample Text"))
-	fmt.Printf("Border:    %s\n", theme.Border.Sprint("Sample Text"))
+	out := outputWriter()
+	fmt.Fprintf(out, "Theme: %s\n", BoldColor.Sprint(theme.Name))
+	fmt.Fprintf(out, "Primary:  %s\n", theme.Primary.Sprint("Sample Text"))
+	fmt.Fprintf(out, "Secondary: %s\n", theme.Secondary.Sprint("Sample Text"))
+	fmt.Fprintf(out, "Success:  %s\n", theme.Success.Sprint("Sample Text"))
+	fmt.Fprintf(out, "Warning:  %s\n", theme.Warning.Sprint("Sample Text"))
+	fmt.Fprintf(out, "Error:    %s\n", theme.Error.Sprint("Sample Text"))
+	fmt.Fprintf(out, "Info:     %s\n", theme.Info.Sprint("Sample Text"))
+	fmt.Fprintf(out, "Muted:    %s\n", theme.Muted.Sprint("Sample Text"))
+	fmt.Fprintf(out, "Background: %s\n", theme.Background.Sprint("Sample Text"))
+	fmt.Fprintf(out, "Text:      %s\n", theme.Text.Sprint("Sample Text"))
+	fmt.Fprintf(out, "Border:    %s\n", theme.Border.Sprint("Sample Text"))
 
 	return nil
 }
 
 // ShowAllThemes displays previews of all available themes
 func ShowAllThemes() {
-	fmt.Println(BoldColor.Sprint("Available Themes:"))
-	fmt.Println()
+	fmt.Fprintln(outputWriter(), BoldColor.Sprint("Available Themes:"))
+	fmt.Fprintln(outputWriter())
 
 	for _, themeName := range GetAvailableThemes() {
 		ThemePreview(themeName)
-		fmt.Println()
+		fmt.Fprintln(outputWriter())
 	}
 }
 
@@ -0,0 +1,38 @@
+package clime
+
+import "testing"
+
+func TestRegisterThemeIgnoresCase(t *testing.T) {
+	defer SetTheme("dark")
+
+	brand := *DarkTheme
+	brand.Name = "Brand"
+	RegisterTheme("Brand", &brand)
+
+	for _, name := range []string{"Brand", "brand", "BRAND"} {
+		if err := SetTheme(name); err != nil {
+			t.Errorf("SetTheme(%q) = %v", name, err)
+		}
+	}
+}
+
+func TestSetThemeFillsNilColors(t *testing.T) {
+	defer SetTheme("dark")
+
+	RegisterTheme("partial", &Theme{Name: "Partial", Success: GreenColor})
+	if err := SetTheme("partial"); err != nil {
+		t.Fatalf("SetTheme = %v", err)
+	}
+
+	if DefaultBorderColor == nil || DefaultTitleColor == nil {
+		t.Error("component defaults left nil")
+	}
+	for i, color := range DefaultChartColors {
+		if color == nil {
+			t.Errorf("chart color %d is nil", i)
+		}
+	}
+	if GetTheme().Success != GreenColor {
+		t.Error("theme's own colors were replaced")
+	}
+}
@@ -0,0 +1,142 @@
+package clime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TreeStyle is the connector style of a tree. Branches are drawn with LeftTee,
+// the last child with BottomLeft, and continuing levels with Vertical
+type TreeStyle = BorderStyle
+
+var (
+	TreeStyleDefault = BorderStyleDefault
+	TreeStyleRounded = BorderStyleRounded
+	TreeStyleBold    = BorderStyleBold
+	TreeStyleDouble  = BorderStyleDouble
+	TreeStyleSimple  = BorderStyleSimple
+	TreeStyleMinimal = BorderStyleMinimal
+)
+
+// TreeNode is a labelled node of a Tree
+type TreeNode struct {
+	Label    string
+	Color    *Color
+	Children []*TreeNode
+}
+
+// WithColor sets the color of the node's label
+func (n *TreeNode) WithColor(color *Color) *TreeNode {
+	n.Color = color
+	return n
+}
+
+type Tree struct {
+	root           *TreeNode
+	style          TreeStyle
+	connectorColor *Color
+}
+
+// NewTree creates a tree with the given root label
+func NewTree(root string) *Tree {
+	return &Tree{
+		root:           &TreeNode{Label: root},
+		style:          TreeStyleDefault,
+		connectorColor: DefaultBorderColor,
+	}
+}
+
+// Root returns the root node
+func (t *Tree) Root() *TreeNode {
+	return t.root
+}
+
+// WithStyle sets the connector style
+func (t *Tree) WithStyle(style TreeStyle) *Tree {
+	t.style = style
+	return t
+}
+
+// WithConnectorColor sets the color of the connector glyphs
+func (t *Tree) WithConnectorColor(color *Color) *Tree {
+	t.connectorColor = color
+	return t
+}
+
+// AddChild adds a node labelled label under parent, or under the root when parent
+// is nil, and returns it so children can be added to it in turn
+func (t *Tree) AddChild(parent *TreeNode, label string) *TreeNode {
+	if parent == nil {
+		parent = t.root
+	}
+
+	child := &TreeNode{Label: label}
+	parent.Children = append(parent.Children, child)
+	return child
+}
+
+// Render renders the tree and returns the string representation
+func (t *Tree) Render() string {
+	var lines []string
+	lines = append(lines, t.renderLabel(t.root, "")...)
+	t.renderChildren(t.root, "", &lines)
+	return strings.Join(lines, "\n")
+}
+
+// renderChildren appends the lines of node's children, each indented by prefix
+func (t *Tree) renderChildren(node *TreeNode, prefix string, lines *[]string) {
+	branch := t.style.LeftTee + strings.Repeat(t.style.Horizontal, 2) + " "
+	last := t.style.BottomLeft + strings.Repeat(t.style.Horizontal, 2) + " "
+	through := t.style.Vertical + "   "
+	blank := "    "
+
+	for i, child := range node.Children {
+		connector, indent := branch, through
+		if i == len(node.Children)-1 {
+			connector, indent = last, blank
+		}
+
+		label := t.renderLabel(child, t.connector(indent))
+		label[0] = prefix + t.connector(connector) + label[0]
+		for j := 1; j < len(label); j++ {
+			label[j] = prefix + label[j]
+		}
+		*lines = append(*lines, label...)
+
+		t.renderChildren(child, prefix+t.connector(indent), lines)
+	}
+}
+
+// renderLabel returns the colored lines of a node's label, continuation lines
+// starting with indent so they stay under the first
+func (t *Tree) renderLabel(node *TreeNode, indent string) []string {
+	lines := strings.Split(node.Label, "\n")
+	for i, line := range lines {
+		if node.Color != nil {
+			line = node.Color.Sprint(line)
+		}
+		if i > 0 {
+			line = indent + line
+		}
+		lines[i] = line
+	}
+	return lines
+}
+
+// connector colors connector glyphs with the connector color
+func (t *Tree) connector(glyphs string) string {
+	if t.connectorColor != nil && strings.TrimSpace(glyphs) != "" {
+		return t.connectorColor.Sprint(glyphs)
+	}
+	return glyphs
+}
+
+// Print renders and prints the tree
+func (t *Tree) Print() {
+	fmt.Fprintln(outputWriter(), t.Render())
+}
+
+// PrintTree prints a tree
+func PrintTree(tree *Tree) {
+	tree.Print()
+}